@@ -0,0 +1,50 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"go-monolith/internal/app/container"
+
+	"github.com/joho/godotenv"
+)
+
+// backfill-owners makes a user the owner of every author that has none, such as
+// authors created before ownership was introduced, so they can be managed again.
+// With -dry-run it only lists those authors.
+func main() {
+	userID := flag.String("user", "", "user ID to make the owner of every unowned author")
+	dryRun := flag.Bool("dry-run", false, "list the unowned authors without changing anything")
+	flag.Parse()
+	if *userID == "" {
+		fmt.Fprintln(os.Stderr, "usage: backfill-owners -user <user ID> [-dry-run]")
+		os.Exit(2)
+	}
+
+	// Load .env.local file only in development/local environment
+	env := os.Getenv("APP_ENV")
+	if env == "" || env == "development" || env == "local" {
+		if err := godotenv.Load(".env.local"); err != nil {
+			log.Printf("Warning: Error loading .env.local file: %v", err)
+		}
+	}
+
+	c := container.NewContainer()
+
+	authorIDs, err := c.AuthorModule.OwnershipService.BackfillOwners(context.Background(), *userID, *dryRun)
+	if err != nil {
+		log.Fatalf("Owner backfill failed after %d authors: %v", len(authorIDs), err)
+	}
+
+	if *dryRun {
+		fmt.Printf("%d authors have no owner:\n", len(authorIDs))
+	} else {
+		fmt.Printf("made %s the owner of %d authors:\n", *userID, len(authorIDs))
+	}
+	for _, id := range authorIDs {
+		fmt.Printf("  author %d\n", id)
+	}
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"go-monolith/internal/app/container"
+
+	"github.com/joho/godotenv"
+)
+
+// consistency-check reports stories whose author no longer exists.
+// It exits with status 1 when orphaned stories are found.
+func main() {
+	// Load .env.local file only in development/local environment
+	env := os.Getenv("APP_ENV")
+	if env == "" || env == "development" || env == "local" {
+		if err := godotenv.Load(".env.local"); err != nil {
+			log.Printf("Warning: Error loading .env.local file: %v", err)
+		}
+	}
+
+	c := container.NewContainer()
+
+	report, err := c.StoryModule.StoryService.FindOrphans(context.Background())
+	if err != nil {
+		log.Fatalf("Consistency check failed: %v", err)
+	}
+
+	fmt.Printf("checked %d authors referenced by stories\n", report.CheckedAuthors)
+	if !report.HasOrphans() {
+		fmt.Println("no orphaned stories")
+		return
+	}
+
+	fmt.Printf("%d orphaned stories across %d missing authors:\n", len(report.Stories), len(report.MissingAuthorIDs))
+	for _, story := range report.Stories {
+		fmt.Printf("  story %d %q references missing author %d\n", story.ID, story.Title, story.AuthorID)
+	}
+	os.Exit(1)
+}
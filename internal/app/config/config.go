@@ -5,7 +5,11 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
+	"time"
 
+	"go-monolith/internal/bff/version"
+	"go-monolith/pkg/httpcache"
 	"go-monolith/pkg/logger"
 	"go-monolith/pkg/metrics"
 )
@@ -17,6 +21,11 @@ type Config struct {
 	Metrics     metrics.Config
 	Server      ServerConfig
 	DB          DBConfig
+	Media       MediaConfig
+	Analytics   AnalyticsConfig
+	Author      AuthorConfig
+	BFF         BFFConfig
+	Auth        AuthConfig
 }
 
 // ServerConfig holds server-specific configuration
@@ -34,6 +43,50 @@ type DBConfig struct {
 	DBName   string
 }
 
+// MediaConfig holds media upload and blob storage configuration
+type MediaConfig struct {
+	LocalDir       string
+	PublicURL      string
+	MaxUploadBytes int64
+	// MaxImageDimension is the largest width or height in pixels an uploaded image may have
+	MaxImageDimension int
+}
+
+// AnalyticsConfig holds analytics rollup configuration
+type AnalyticsConfig struct {
+	CompactionInterval time.Duration
+}
+
+// AuthorConfig holds author profile configuration
+type AuthorConfig struct {
+	ProfilePageBaseURL     string
+	StatsReconcileInterval time.Duration
+	// DeletePolicy is block, reassign or cascade, see the author module's DeletePolicy
+	DeletePolicy     string
+	DeleteReassignTo uint
+}
+
+// BFFConfig holds configuration of the backend-for-frontend layer
+type BFFConfig struct {
+	// SectionTimeout bounds how long an optional response section may take to load
+	SectionTimeout time.Duration
+	// CacheTTL is how long story responses are cached; zero disables the cache
+	CacheTTL      time.Duration
+	CacheCapacity int
+	// CacheControl is sent with conditional responses, unless CacheControlRoutes
+	// has a value for the route path
+	CacheControl       string
+	CacheControlRoutes map[string]string
+	// VersionSunsets retire API versions or single routes, see version.ParseSunsets
+	VersionSunsets map[string]time.Time
+}
+
+// AuthConfig holds authorization configuration
+type AuthConfig struct {
+	// AdminUsers may verify and merge authors
+	AdminUsers []string
+}
+
 // MetricsConfig holds metrics configuration
 type MetricsConfig struct {
 	Host     string  `env:"METRICS_HOST" envDefault:"localhost"`
@@ -108,6 +161,103 @@ func NewConfig() (*Config, error) {
 		return nil, err
 	}
 
+	maxUploadBytes, err := strconv.ParseInt(getEnvOrDefault("MEDIA_MAX_UPLOAD_BYTES", "10485760"), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid MEDIA_MAX_UPLOAD_BYTES: %w", err)
+	}
+
+	maxImageDimension, err := strconv.Atoi(getEnvOrDefault("MEDIA_MAX_IMAGE_DIMENSION", "8192"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid MEDIA_MAX_IMAGE_DIMENSION: %w", err)
+	}
+	if maxImageDimension <= 0 {
+		return nil, fmt.Errorf("invalid MEDIA_MAX_IMAGE_DIMENSION: must be positive, got %d", maxImageDimension)
+	}
+
+	mediaConfig := MediaConfig{
+		LocalDir:          getEnvOrDefault("MEDIA_LOCAL_DIR", "./uploads"),
+		PublicURL:         getEnvOrDefault("MEDIA_PUBLIC_URL", "/media"),
+		MaxUploadBytes:    maxUploadBytes,
+		MaxImageDimension: maxImageDimension,
+	}
+
+	compactionInterval, err := time.ParseDuration(getEnvOrDefault("ANALYTICS_COMPACTION_INTERVAL", "5m"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid ANALYTICS_COMPACTION_INTERVAL: %w", err)
+	}
+	if compactionInterval <= 0 {
+		return nil, fmt.Errorf("invalid ANALYTICS_COMPACTION_INTERVAL: must be positive, got %s", compactionInterval)
+	}
+
+	analyticsConfig := AnalyticsConfig{
+		CompactionInterval: compactionInterval,
+	}
+
+	statsReconcileInterval, err := time.ParseDuration(getEnvOrDefault("AUTHOR_STATS_RECONCILE_INTERVAL", "24h"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid AUTHOR_STATS_RECONCILE_INTERVAL: %w", err)
+	}
+	if statsReconcileInterval <= 0 {
+		return nil, fmt.Errorf("invalid AUTHOR_STATS_RECONCILE_INTERVAL: must be positive, got %s", statsReconcileInterval)
+	}
+
+	deleteReassignTo, err := strconv.ParseUint(getEnvOrDefault("AUTHOR_DELETE_REASSIGN_TO", "0"), 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid AUTHOR_DELETE_REASSIGN_TO: %w", err)
+	}
+
+	authorConfig := AuthorConfig{
+		ProfilePageBaseURL:     getEnvOrDefault("AUTHOR_PROFILE_BASE_URL", "/authors"),
+		StatsReconcileInterval: statsReconcileInterval,
+		DeletePolicy:           getEnvOrDefault("AUTHOR_DELETE_POLICY", "block"),
+		DeleteReassignTo:       uint(deleteReassignTo),
+	}
+
+	sectionTimeout, err := time.ParseDuration(getEnvOrDefault("BFF_SECTION_TIMEOUT", "1s"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid BFF_SECTION_TIMEOUT: %w", err)
+	}
+
+	cacheTTL, err := time.ParseDuration(getEnvOrDefault("BFF_CACHE_TTL", "5m"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid BFF_CACHE_TTL: %w", err)
+	}
+
+	cacheCapacity, err := strconv.Atoi(getEnvOrDefault("BFF_CACHE_CAPACITY", "10000"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid BFF_CACHE_CAPACITY: %w", err)
+	}
+
+	cacheControlRoutes, err := httpcache.ParseRoutes(os.Getenv("BFF_CACHE_CONTROL_ROUTES"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid BFF_CACHE_CONTROL_ROUTES: %w", err)
+	}
+
+	versionSunsets, err := version.ParseSunsets(os.Getenv("BFF_VERSION_SUNSETS"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid BFF_VERSION_SUNSETS: %w", err)
+	}
+
+	bffConfig := BFFConfig{
+		SectionTimeout:     sectionTimeout,
+		CacheTTL:           cacheTTL,
+		CacheCapacity:      cacheCapacity,
+		CacheControl:       getEnvOrDefault("BFF_CACHE_CONTROL", httpcache.DefaultCacheControl),
+		CacheControlRoutes: cacheControlRoutes,
+		VersionSunsets:     versionSunsets,
+	}
+
+	var adminUsers []string
+	for _, userID := range strings.Split(os.Getenv("AUTH_ADMIN_USERS"), ",") {
+		if userID = strings.TrimSpace(userID); userID != "" {
+			adminUsers = append(adminUsers, userID)
+		}
+	}
+
+	authConfig := AuthConfig{
+		AdminUsers: adminUsers,
+	}
+
 	serverPort := os.Getenv("SERVER_PORT")
 	if serverPort == "" {
 		return nil, errors.New("SERVER_PORT is required")
@@ -124,6 +274,11 @@ func NewConfig() (*Config, error) {
 		Metrics:     metricsConfig,
 		Server:      serverConfig,
 		DB:          dbConfig,
+		Media:       mediaConfig,
+		Analytics:   analyticsConfig,
+		Author:      authorConfig,
+		BFF:         bffConfig,
+		Auth:        authConfig,
 	}, nil
 }
 
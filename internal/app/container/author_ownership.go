@@ -0,0 +1,34 @@
+package container
+
+import (
+	"context"
+	"strconv"
+
+	authorService "go-monolith/internal/modules/author/service"
+	storyService "go-monolith/internal/modules/story/service"
+)
+
+// authorOwnership resolves resource ownership for the permission layer: users own
+// the authors they manage and the stories written by those authors
+type authorOwnership struct {
+	owners  *authorService.OwnershipService
+	stories *storyService.StoryService
+}
+
+func (o *authorOwnership) IsOwner(ctx context.Context, userID string, resource string, resourceID string) (bool, error) {
+	switch resource {
+	case "author":
+		authorID, err := strconv.ParseUint(resourceID, 10, 32)
+		if err != nil {
+			return false, nil
+		}
+		return o.owners.IsOwner(ctx, uint(authorID), userID)
+	case "story":
+		story, err := o.stories.GetByID(ctx, resourceID)
+		if err != nil {
+			return false, err
+		}
+		return o.owners.IsOwner(ctx, story.AuthorID, userID)
+	}
+	return false, nil
+}
@@ -0,0 +1,26 @@
+package container
+
+import (
+	"context"
+
+	storyService "go-monolith/internal/modules/story/service"
+)
+
+// authorStories gives the author module's delete policy access to the story module.
+// The story module is created after the author module, which it checks authors with,
+// so the service is set once both exist.
+type authorStories struct {
+	stories *storyService.StoryService
+}
+
+func (a *authorStories) CountByAuthor(ctx context.Context, authorID uint) (int64, error) {
+	return a.stories.CountByAuthor(ctx, authorID)
+}
+
+func (a *authorStories) ReassignAuthor(ctx context.Context, fromID, toID uint) (int, error) {
+	return a.stories.ReassignAuthor(ctx, fromID, toID)
+}
+
+func (a *authorStories) DeleteByAuthor(ctx context.Context, authorID uint) (int, error) {
+	return a.stories.DeleteByAuthor(ctx, authorID)
+}
@@ -3,12 +3,29 @@ package container
 import (
 	"go-monolith/internal/app/config"
 	"go-monolith/internal/bff/data"
+	"go-monolith/internal/bff/graphql"
 	"go-monolith/internal/bff/handler"
+	"go-monolith/internal/bff/handler/builder"
 	"go-monolith/internal/bff/service"
+	"go-monolith/internal/bff/version"
+	"go-monolith/internal/modules/analytics"
 	"go-monolith/internal/modules/author"
+	authordomain "go-monolith/internal/modules/author/domain"
+	"go-monolith/internal/modules/follow"
+	"go-monolith/internal/modules/media"
+	"go-monolith/internal/modules/notification"
+	"go-monolith/internal/modules/progress"
+	"go-monolith/internal/modules/readinglist"
 	"go-monolith/internal/modules/story"
+	storydomain "go-monolith/internal/modules/story/domain"
+	"go-monolith/pkg/auth"
+	"go-monolith/pkg/cache"
+	"go-monolith/pkg/events"
+	"go-monolith/pkg/httpcache"
 	"go-monolith/pkg/logger"
 	"go-monolith/pkg/metrics"
+	"go-monolith/pkg/scheduler"
+	"go-monolith/pkg/storage"
 	"log"
 
 	"gorm.io/driver/mysql"
@@ -17,16 +34,40 @@ import (
 
 // Container holds all application dependencies
 type Container struct {
-	Config       *config.Config
-	Logger       logger.Logger
-	Metrics      *metrics.Client
-	DB           *gorm.DB
-	StoryModule  *story.Module
-	AuthorModule *author.Module
-	StoryRepo    *data.StoryProvider
-	AuthorRepo   *data.AuthorProvider
-	StoryService *service.StoryService
-	Handlers     *handler.Handlers
+	Config              *config.Config
+	Logger              logger.Logger
+	Metrics             *metrics.Client
+	DB                  *gorm.DB
+	Events              *events.Bus
+	Scheduler           *scheduler.Scheduler
+	StoryModule         *story.Module
+	AuthorModule        *author.Module
+	MediaModule         *media.Module
+	AnalyticsModule     *analytics.Module
+	ProgressModule      *progress.Module
+	ReadingListModule   *readinglist.Module
+	FollowModule        *follow.Module
+	NotificationModule  *notification.Module
+	BlobStore           *storage.LocalBlobStore
+	StoryRepo           *data.StoryProvider
+	AuthorRepo          *data.AuthorProvider
+	MediaRepo           *data.MediaProvider
+	AnalyticsRepo       *data.AnalyticsProvider
+	ProgressRepo        *data.ProgressProvider
+	ReadingListRepo     *data.ReadingListProvider
+	FollowRepo          *data.FollowProvider
+	NotificationRepo    *data.NotificationProvider
+	StoryService        *service.StoryService
+	AuthorService       *service.AuthorService
+	MediaService        *service.MediaService
+	AnalyticsService    *service.AnalyticsService
+	ProgressService     *service.ProgressService
+	ReadingListService  *service.ReadingListService
+	FollowService       *service.FollowService
+	FeedService         *service.FeedService
+	NotificationService *service.NotificationService
+	Handlers            *handler.Handlers
+	PermissionVerifier  auth.PermissionVerifier
 }
 
 // NewContainer creates a new dependency container
@@ -62,30 +103,137 @@ func NewContainer() *Container {
 		}
 	}
 
-	// Initialize modules
-	storyModule := story.NewModule(db, logger, metricsClient)
-	authorModule := author.NewModule(db, logger, metricsClient)
+	// Initialize blob storage for uploaded media
+	blobStore, err := storage.NewLocalBlobStore(cfg.Media.LocalDir, cfg.Media.PublicURL)
+	if err != nil {
+		log.Fatalf("Failed to initialize blob store: %v", err)
+	}
+
+	// Initialize the domain event bus shared by all modules
+	bus := events.NewBus(logger)
+
+	deletePolicy, err := authordomain.NewDeletePolicy(cfg.Author.DeletePolicy, cfg.Author.DeleteReassignTo)
+	if err != nil {
+		log.Fatalf("Failed to configure author deletion: %v", err)
+	}
+
+	// Initialize modules. Stories check their author through the author module, which
+	// in turn applies its delete policy to the author's stories through the story module.
+	// Other modules clean up after deleted stories and authors from their events.
+	stories := &authorStories{}
+	authorModule := author.NewModule(db, bus, stories, deletePolicy, logger, metricsClient)
+	storyModule := story.NewModule(db, bus, authorModule.AuthorService, logger, metricsClient)
+	stories.stories = storyModule.StoryService
+	mediaModule := media.NewModule(db, bus, blobStore, cfg.Media.MaxUploadBytes, cfg.Media.MaxImageDimension, logger, metricsClient)
+	analyticsModule := analytics.NewModule(db, bus, logger, metricsClient)
+	progressModule := progress.NewModule(db, bus, logger, metricsClient)
+	readingListModule := readinglist.NewModule(db, bus, logger, metricsClient)
+	followModule := follow.NewModule(db, bus, logger, metricsClient)
+	notificationModule := notification.NewModule(db, bus, &notificationAudience{
+		follows: followModule.FollowService,
+		owners:  authorModule.OwnershipService,
+	}, logger, metricsClient)
+
+	// Initialize background jobs
+	jobs := scheduler.New(logger, metricsClient)
+	jobs.Register(analyticsModule.CompactionJob, cfg.Analytics.CompactionInterval)
+	jobs.Register(authorModule.StatsReconcileJob, cfg.Author.StatsReconcileInterval)
 
 	// Initialize repositories
 	storyRepo := data.NewStoryProvider(storyModule.StoryService)
-	authorRepo := data.NewAuthorProvider(authorModule.AuthorService)
+	authorRepo := data.NewAuthorProvider(authorModule.AuthorService, authorModule.StatsService, authorModule.OwnershipService)
+	mediaRepo := data.NewMediaProvider(mediaModule.MediaService)
+	analyticsRepo := data.NewAnalyticsProvider(analyticsModule.AnalyticsService)
+	progressRepo := data.NewProgressProvider(progressModule.ProgressService)
+	readingListRepo := data.NewReadingListProvider(readingListModule.ReadingListService)
+	followRepo := data.NewFollowProvider(followModule.FollowService)
+	notificationRepo := data.NewNotificationProvider(notificationModule.NotificationService)
 
 	// Initialize BFF service
-	storyService := service.NewStoryService(storyRepo, authorRepo, logger, metricsClient)
+	service.NewSectionLoader(cfg.BFF.SectionTimeout, logger, metricsClient)
+	if cfg.BFF.CacheTTL > 0 {
+		// Story responses are cached until the story or its author changes
+		responseCache := service.NewResponseCache(cache.NewLRU(cfg.BFF.CacheCapacity), cfg.BFF.CacheTTL, logger, metricsClient)
+		bus.Subscribe(storydomain.EventStoryUpdated, responseCache.HandleStoryChanged)
+		bus.Subscribe(storydomain.EventStoryPublished, responseCache.HandleStoryChanged)
+		bus.Subscribe(storydomain.EventStoryDeleted, responseCache.HandleStoryChanged)
+		bus.Subscribe(authordomain.EventAuthorUpdated, responseCache.HandleAuthorChanged)
+		bus.Subscribe(authordomain.EventAuthorDeleted, responseCache.HandleAuthorChanged)
+		bus.Subscribe(authordomain.EventAuthorsMerged, responseCache.HandleAuthorChanged)
+	}
+	storyService := service.NewStoryService(storyRepo, authorRepo, analyticsRepo, logger, metricsClient)
+	authorService := service.NewAuthorService(authorRepo, logger, metricsClient)
+	mediaService := service.NewMediaService(storyRepo, mediaRepo, logger, metricsClient)
+	analyticsService := service.NewAnalyticsService(analyticsRepo, logger, metricsClient)
+	progressService := service.NewProgressService(storyRepo, authorRepo, progressRepo, logger, metricsClient)
+	readingListService := service.NewReadingListService(storyRepo, authorRepo, readingListRepo, logger, metricsClient)
+	followService := service.NewFollowService(authorRepo, followRepo, logger, metricsClient)
+	feedService := service.NewFeedService(storyRepo, authorRepo, followRepo, logger, metricsClient)
+	notificationService := service.NewNotificationService(notificationRepo, logger, metricsClient)
+	graphqlSchema := graphql.NewSchema(storyRepo, authorRepo, logger, metricsClient)
 
 	// Initialize handlers
-	handlers := handler.NewHandlers(storyService)
+	builder.SetProfilePageBaseURL(cfg.Author.ProfilePageBaseURL)
+	httpcache.SetCacheControl(cfg.BFF.CacheControl, cfg.BFF.CacheControlRoutes)
+	version.Configure(cfg.BFF.VersionSunsets, metricsClient)
+	handlers := handler.NewHandlers(storyService, authorService, mediaService, analyticsService, progressService, readingListService, followService, feedService, notificationService, graphqlSchema)
+
+	// Initialize permissions: changes to authors and their stories, including who
+	// owns an author, and reading an author's daily stats require ownership, while
+	// verifying and merging authors is left to administrators. Administrators may
+	// also manage any author, so authors without owners are not locked (the
+	// underlying verifier is still a mock).
+	permissionVerifier := auth.NewAdminVerifier(
+		auth.NewAdminOverride(
+			auth.NewOwnershipVerifier(
+				auth.NewMockPermissionVerifier(),
+				&authorOwnership{owners: authorModule.OwnershipService, stories: storyModule.StoryService},
+				[]string{"author", "story"},
+				[]string{"update", "delete", "publish", "manage_owners", "view_stats"},
+			),
+			cfg.Auth.AdminUsers,
+			[]string{"author"},
+			[]string{"update", "delete", "manage_owners", "view_stats"},
+		),
+		cfg.Auth.AdminUsers,
+		[]string{"author"},
+		[]string{"verify", "merge"},
+	)
 
 	return &Container{
-		Config:       cfg,
-		Logger:       logger,
-		Metrics:      metricsClient,
-		DB:           db,
-		StoryModule:  storyModule,
-		AuthorModule: authorModule,
-		StoryRepo:    storyRepo,
-		AuthorRepo:   authorRepo,
-		StoryService: storyService,
-		Handlers:     handlers,
+		Config:              cfg,
+		Logger:              logger,
+		Metrics:             metricsClient,
+		DB:                  db,
+		Events:              bus,
+		Scheduler:           jobs,
+		StoryModule:         storyModule,
+		AuthorModule:        authorModule,
+		MediaModule:         mediaModule,
+		AnalyticsModule:     analyticsModule,
+		ProgressModule:      progressModule,
+		ReadingListModule:   readingListModule,
+		FollowModule:        followModule,
+		NotificationModule:  notificationModule,
+		BlobStore:           blobStore,
+		StoryRepo:           storyRepo,
+		AuthorRepo:          authorRepo,
+		MediaRepo:           mediaRepo,
+		AnalyticsRepo:       analyticsRepo,
+		ProgressRepo:        progressRepo,
+		ReadingListRepo:     readingListRepo,
+		FollowRepo:          followRepo,
+		NotificationRepo:    notificationRepo,
+		StoryService:        storyService,
+		AuthorService:       authorService,
+		MediaService:        mediaService,
+		AnalyticsService:    analyticsService,
+		ProgressService:     progressService,
+		ReadingListService:  readingListService,
+		FollowService:       followService,
+		FeedService:         feedService,
+		NotificationService: notificationService,
+		Handlers:            handlers,
+		PermissionVerifier:  permissionVerifier,
 	}
 }
@@ -0,0 +1,23 @@
+package container
+
+import (
+	"context"
+
+	authorService "go-monolith/internal/modules/author/service"
+	followService "go-monolith/internal/modules/follow/service"
+)
+
+// notificationAudience resolves notification recipients from the follow and author modules
+type notificationAudience struct {
+	follows *followService.FollowService
+	owners  *authorService.OwnershipService
+}
+
+func (a *notificationAudience) Followers(ctx context.Context, authorID uint) ([]string, error) {
+	return a.follows.ListFollowerIDs(ctx, authorID)
+}
+
+// Owners returns the users managing the author, who are notified about likes and comments
+func (a *notificationAudience) Owners(ctx context.Context, authorID uint) ([]string, error) {
+	return a.owners.ListOwnerIDs(ctx, authorID)
+}
@@ -59,12 +59,14 @@ func NewServer() *Server {
 }
 
 func (s *Server) SetupRoutes() {
-	// Initialize auth components (using mocks for now)
-	permissionVerifier := auth.NewMockPermissionVerifier()
+	permissionVerifier := s.container.PermissionVerifier
 
 	// Setup public routes (no authentication required)
 	routes.SetupPublicRoutes(s.router)
 
+	// Serve uploaded media from the local blob store
+	s.router.Static(s.container.Config.Media.PublicURL, s.container.BlobStore.BaseDir())
+
 	// Setup protected routes (authentication required)
 	protectedRouter := s.router.Group("")
 	protectedRouter.Use(auth.AuthMiddleware())
@@ -72,6 +74,9 @@ func (s *Server) SetupRoutes() {
 }
 
 func (s *Server) Start() error {
+	// Start background jobs
+	s.container.Scheduler.Start()
+
 	// Start the server
 	go func() {
 		log.Printf("Server is starting on %s\n", s.server.Addr)
@@ -93,6 +98,9 @@ func (s *Server) Start() error {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
+	// Stop background jobs before the server so in-flight runs can finish
+	s.container.Scheduler.Stop(ctx)
+
 	if err := s.server.Shutdown(ctx); err != nil {
 		log.Printf("Server forced to shutdown: %v\n", err)
 		return err
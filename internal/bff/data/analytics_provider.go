@@ -0,0 +1,32 @@
+package data
+
+import (
+	"context"
+	"strconv"
+
+	analyticsdomain "go-monolith/internal/modules/analytics/domain"
+	analyticsModuleService "go-monolith/internal/modules/analytics/service"
+	"go-monolith/pkg/errors"
+)
+
+type AnalyticsProvider struct {
+	analyticsService *analyticsModuleService.AnalyticsService
+}
+
+func NewAnalyticsProvider(as *analyticsModuleService.AnalyticsService) *AnalyticsProvider {
+	return &AnalyticsProvider{
+		analyticsService: as,
+	}
+}
+
+func (p *AnalyticsProvider) GetAuthorStats(ctx context.Context, id string, from, to string) (*analyticsdomain.AuthorStats, error) {
+	authorID, err := strconv.ParseUint(id, 10, 32)
+	if err != nil {
+		return nil, errors.NewValidationError("invalid author ID format")
+	}
+	return p.analyticsService.GetAuthorStats(ctx, uint(authorID), from, to)
+}
+
+func (p *AnalyticsProvider) RecordView(ctx context.Context, storyID, authorID uint) error {
+	return p.analyticsService.RecordView(ctx, storyID, authorID)
+}
@@ -6,15 +6,20 @@ import (
 
 	authordomain "go-monolith/internal/modules/author/domain"
 	authorModuleService "go-monolith/internal/modules/author/service"
+	"go-monolith/pkg/errors"
 )
 
 type AuthorProvider struct {
-	authorService *authorModuleService.AuthorService
+	authorService    *authorModuleService.AuthorService
+	statsService     *authorModuleService.StatsService
+	ownershipService *authorModuleService.OwnershipService
 }
 
-func NewAuthorProvider(as *authorModuleService.AuthorService) *AuthorProvider {
+func NewAuthorProvider(as *authorModuleService.AuthorService, ss *authorModuleService.StatsService, ows *authorModuleService.OwnershipService) *AuthorProvider {
 	return &AuthorProvider{
-		authorService: as,
+		authorService:    as,
+		statsService:     ss,
+		ownershipService: ows,
 	}
 }
 
@@ -25,3 +30,119 @@ func (p *AuthorProvider) GetAuthor(ctx context.Context, id string) (*authordomai
 	}
 	return p.authorService.GetByID(ctx, uint(authorID))
 }
+
+func (p *AuthorProvider) GetAuthors(ctx context.Context, ids []uint) ([]*authordomain.Author, error) {
+	return p.authorService.GetByIDs(ctx, ids)
+}
+
+func (p *AuthorProvider) ListAuthors(ctx context.Context, prefix, sort, order, cursor string, limit int) (*authordomain.AuthorPage, error) {
+	return p.authorService.List(ctx, prefix, sort, order, cursor, limit)
+}
+
+func (p *AuthorProvider) GetAuthorBySlug(ctx context.Context, slug string) (*authordomain.Author, error) {
+	return p.authorService.GetBySlug(ctx, slug)
+}
+
+func (p *AuthorProvider) ChangeAuthorSlug(ctx context.Context, id string, slug string) (*authordomain.Author, error) {
+	authorID, err := parseAuthorID(id)
+	if err != nil {
+		return nil, err
+	}
+	return p.authorService.ChangeSlug(ctx, authorID, slug)
+}
+
+func (p *AuthorProvider) UpdateAuthorProfile(ctx context.Context, id string, bio, location string, links []authordomain.Link) (*authordomain.Author, error) {
+	authorID, err := parseAuthorID(id)
+	if err != nil {
+		return nil, err
+	}
+	return p.authorService.UpdateProfile(ctx, authorID, bio, location, links)
+}
+
+func (p *AuthorProvider) VerifyAuthor(ctx context.Context, id string, verifiedBy string) (*authordomain.Author, error) {
+	authorID, err := parseAuthorID(id)
+	if err != nil {
+		return nil, err
+	}
+	return p.authorService.Verify(ctx, authorID, verifiedBy)
+}
+
+func (p *AuthorProvider) UnverifyAuthor(ctx context.Context, id string) (*authordomain.Author, error) {
+	authorID, err := parseAuthorID(id)
+	if err != nil {
+		return nil, err
+	}
+	return p.authorService.Unverify(ctx, authorID)
+}
+
+func (p *AuthorProvider) GetAuthorAggregates(ctx context.Context, authorIDs []uint) (map[uint]*authordomain.Stats, error) {
+	return p.statsService.GetManyStats(ctx, authorIDs)
+}
+
+func (p *AuthorProvider) MergeAuthors(ctx context.Context, sourceID, targetID string, mergedBy string, dryRun bool) (*authordomain.MergePlan, error) {
+	source, err := parseAuthorID(sourceID)
+	if err != nil {
+		return nil, err
+	}
+	target, err := parseAuthorID(targetID)
+	if err != nil {
+		return nil, err
+	}
+	return p.authorService.MergeAuthors(ctx, source, target, mergedBy, dryRun)
+}
+
+func (p *AuthorProvider) ListOwnedAuthors(ctx context.Context, userID string) ([]*authordomain.Author, error) {
+	return p.ownershipService.ListOwnedAuthors(ctx, userID)
+}
+
+func (p *AuthorProvider) AddAuthorOwner(ctx context.Context, id string, userID string) error {
+	authorID, err := parseAuthorID(id)
+	if err != nil {
+		return err
+	}
+	return p.ownershipService.AddOwner(ctx, authorID, userID)
+}
+
+func (p *AuthorProvider) RemoveAuthorOwner(ctx context.Context, id string, userID string) error {
+	authorID, err := parseAuthorID(id)
+	if err != nil {
+		return err
+	}
+	return p.ownershipService.RemoveOwner(ctx, authorID, userID)
+}
+
+func (p *AuthorProvider) IsAuthorOwner(ctx context.Context, authorID uint, userID string) (bool, error) {
+	return p.ownershipService.IsOwner(ctx, authorID, userID)
+}
+
+func (p *AuthorProvider) CreateAuthor(ctx context.Context, firstName, lastName, profileImageURL, slug, ownerID string) (*authordomain.Author, error) {
+	return p.authorService.Create(ctx, firstName, lastName, profileImageURL, slug, ownerID)
+}
+
+func (p *AuthorProvider) UpdateAuthor(ctx context.Context, id string, firstName, lastName, profileImageURL string) (*authordomain.Author, error) {
+	authorID, err := parseAuthorID(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.authorService.Update(ctx, authorID, firstName, lastName, profileImageURL); err != nil {
+		return nil, err
+	}
+	return p.authorService.GetByID(ctx, authorID)
+}
+
+func (p *AuthorProvider) DeleteAuthor(ctx context.Context, id string) error {
+	authorID, err := parseAuthorID(id)
+	if err != nil {
+		return err
+	}
+	return p.authorService.Delete(ctx, authorID)
+}
+
+// parseAuthorID parses an author ID for write operations
+func parseAuthorID(id string) (uint, error) {
+	authorID, err := strconv.ParseUint(id, 10, 32)
+	if err != nil {
+		return 0, errors.NewValidationError("invalid author ID format")
+	}
+	return uint(authorID), nil
+}
@@ -0,0 +1,34 @@
+package data
+
+import (
+	"context"
+
+	followModuleService "go-monolith/internal/modules/follow/service"
+)
+
+type FollowProvider struct {
+	followService *followModuleService.FollowService
+}
+
+func NewFollowProvider(fs *followModuleService.FollowService) *FollowProvider {
+	return &FollowProvider{
+		followService: fs,
+	}
+}
+
+func (p *FollowProvider) Follow(ctx context.Context, userID string, authorID string) error {
+	_, err := p.followService.Follow(ctx, userID, authorID)
+	return err
+}
+
+func (p *FollowProvider) Unfollow(ctx context.Context, userID string, authorID string) error {
+	return p.followService.Unfollow(ctx, userID, authorID)
+}
+
+func (p *FollowProvider) IsFollowing(ctx context.Context, userID string, authorID string) (bool, error) {
+	return p.followService.IsFollowing(ctx, userID, authorID)
+}
+
+func (p *FollowProvider) ListFollowedAuthorIDs(ctx context.Context, userID string) ([]uint, error) {
+	return p.followService.ListFollowedAuthorIDs(ctx, userID)
+}
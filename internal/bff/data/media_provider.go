@@ -0,0 +1,27 @@
+package data
+
+import (
+	"context"
+	"io"
+
+	mediadomain "go-monolith/internal/modules/media/domain"
+	mediaModuleService "go-monolith/internal/modules/media/service"
+)
+
+type MediaProvider struct {
+	mediaService *mediaModuleService.MediaService
+}
+
+func NewMediaProvider(ms *mediaModuleService.MediaService) *MediaProvider {
+	return &MediaProvider{
+		mediaService: ms,
+	}
+}
+
+func (p *MediaProvider) UploadStoryMedia(ctx context.Context, storyID string, kind string, r io.Reader) (*mediadomain.Media, error) {
+	return p.mediaService.Upload(ctx, storyID, kind, r)
+}
+
+func (p *MediaProvider) GetCoverImage(ctx context.Context, storyID string) (*mediadomain.Media, error) {
+	return p.mediaService.GetCoverImage(ctx, storyID)
+}
@@ -0,0 +1,34 @@
+package data
+
+import (
+	"context"
+
+	notificationdomain "go-monolith/internal/modules/notification/domain"
+	notificationModuleService "go-monolith/internal/modules/notification/service"
+)
+
+type NotificationProvider struct {
+	notificationService *notificationModuleService.NotificationService
+}
+
+func NewNotificationProvider(ns *notificationModuleService.NotificationService) *NotificationProvider {
+	return &NotificationProvider{
+		notificationService: ns,
+	}
+}
+
+func (p *NotificationProvider) ListNotifications(ctx context.Context, userID string, unreadOnly bool, limit, offset int) ([]*notificationdomain.Notification, error) {
+	return p.notificationService.List(ctx, userID, unreadOnly, limit, offset)
+}
+
+func (p *NotificationProvider) UnreadCount(ctx context.Context, userID string) (int64, error) {
+	return p.notificationService.UnreadCount(ctx, userID)
+}
+
+func (p *NotificationProvider) MarkRead(ctx context.Context, userID string, notificationID string) error {
+	return p.notificationService.MarkRead(ctx, userID, notificationID)
+}
+
+func (p *NotificationProvider) MarkAllRead(ctx context.Context, userID string) (int64, error) {
+	return p.notificationService.MarkAllRead(ctx, userID)
+}
@@ -0,0 +1,30 @@
+package data
+
+import (
+	"context"
+
+	progressdomain "go-monolith/internal/modules/progress/domain"
+	progressModuleService "go-monolith/internal/modules/progress/service"
+)
+
+type ProgressProvider struct {
+	progressService *progressModuleService.ProgressService
+}
+
+func NewProgressProvider(ps *progressModuleService.ProgressService) *ProgressProvider {
+	return &ProgressProvider{
+		progressService: ps,
+	}
+}
+
+func (p *ProgressProvider) SaveProgress(ctx context.Context, userID string, storyID string, percent *float64, offset *int64) (*progressdomain.ReadingProgress, error) {
+	return p.progressService.SaveProgress(ctx, userID, storyID, percent, offset)
+}
+
+func (p *ProgressProvider) GetProgress(ctx context.Context, userID string, storyID string) (*progressdomain.ReadingProgress, error) {
+	return p.progressService.GetProgress(ctx, userID, storyID)
+}
+
+func (p *ProgressProvider) ListContinueReading(ctx context.Context, userID string, limit int) ([]*progressdomain.ReadingProgress, error) {
+	return p.progressService.ListContinueReading(ctx, userID, limit)
+}
@@ -2,17 +2,99 @@ package data
 
 import (
 	"context"
+	"io"
 
+	analyticsdomain "go-monolith/internal/modules/analytics/domain"
 	authordomain "go-monolith/internal/modules/author/domain"
+	mediadomain "go-monolith/internal/modules/media/domain"
+	notificationdomain "go-monolith/internal/modules/notification/domain"
+	progressdomain "go-monolith/internal/modules/progress/domain"
+	readinglistdomain "go-monolith/internal/modules/readinglist/domain"
 	storydomain "go-monolith/internal/modules/story/domain"
 )
 
 // StoryDataProvider defines the interface for story data operations
 type StoryDataProvider interface {
 	GetStory(ctx context.Context, storyID string) (*storydomain.Story, error)
+	LikeStory(ctx context.Context, storyID string, userID string) (*storydomain.Story, bool, error)
+	ListFeed(ctx context.Context, authorIDs []uint, after *storydomain.FeedCursor, limit int) ([]*storydomain.Story, error)
+	ListTrending(ctx context.Context, limit, offset int) ([]*storydomain.Story, error)
+	GetStories(ctx context.Context, storyIDs []uint) ([]*storydomain.Story, error)
+	ListStories(ctx context.Context, limit, offset int) ([]*storydomain.Story, error)
+	ListStoriesByAuthor(ctx context.Context, authorID string, includeDrafts bool, limit, offset int) ([]*storydomain.Story, error)
+	CreateStory(ctx context.Context, title, content, authorID string) (*storydomain.Story, error)
+	UpdateStory(ctx context.Context, storyID string, title, content string) (*storydomain.Story, error)
+	PublishStory(ctx context.Context, storyID string) (*storydomain.Story, error)
+	DeleteStory(ctx context.Context, storyID string) error
 }
 
 // AuthorDataProvider defines the interface for author data operations
 type AuthorDataProvider interface {
 	GetAuthor(ctx context.Context, authorID string) (*authordomain.Author, error)
+	GetAuthors(ctx context.Context, authorIDs []uint) ([]*authordomain.Author, error)
+	GetAuthorBySlug(ctx context.Context, slug string) (*authordomain.Author, error)
+	ListAuthors(ctx context.Context, prefix, sort, order, cursor string, limit int) (*authordomain.AuthorPage, error)
+	ChangeAuthorSlug(ctx context.Context, authorID string, slug string) (*authordomain.Author, error)
+	UpdateAuthorProfile(ctx context.Context, authorID string, bio, location string, links []authordomain.Link) (*authordomain.Author, error)
+	VerifyAuthor(ctx context.Context, authorID string, verifiedBy string) (*authordomain.Author, error)
+	UnverifyAuthor(ctx context.Context, authorID string) (*authordomain.Author, error)
+	GetAuthorAggregates(ctx context.Context, authorIDs []uint) (map[uint]*authordomain.Stats, error)
+	MergeAuthors(ctx context.Context, sourceID, targetID string, mergedBy string, dryRun bool) (*authordomain.MergePlan, error)
+	ListOwnedAuthors(ctx context.Context, userID string) ([]*authordomain.Author, error)
+	AddAuthorOwner(ctx context.Context, authorID string, userID string) error
+	RemoveAuthorOwner(ctx context.Context, authorID string, userID string) error
+	IsAuthorOwner(ctx context.Context, authorID uint, userID string) (bool, error)
+	CreateAuthor(ctx context.Context, firstName, lastName, profileImageURL, slug, ownerID string) (*authordomain.Author, error)
+	UpdateAuthor(ctx context.Context, authorID string, firstName, lastName, profileImageURL string) (*authordomain.Author, error)
+	DeleteAuthor(ctx context.Context, authorID string) error
+}
+
+// MediaDataProvider defines the interface for story media operations
+type MediaDataProvider interface {
+	UploadStoryMedia(ctx context.Context, storyID string, kind string, r io.Reader) (*mediadomain.Media, error)
+	GetCoverImage(ctx context.Context, storyID string) (*mediadomain.Media, error)
+}
+
+// AnalyticsDataProvider defines the interface for engagement analytics operations
+type AnalyticsDataProvider interface {
+	GetAuthorStats(ctx context.Context, authorID string, from, to string) (*analyticsdomain.AuthorStats, error)
+	RecordView(ctx context.Context, storyID, authorID uint) error
+}
+
+// ProgressDataProvider defines the interface for reading progress operations
+type ProgressDataProvider interface {
+	SaveProgress(ctx context.Context, userID string, storyID string, percent *float64, offset *int64) (*progressdomain.ReadingProgress, error)
+	GetProgress(ctx context.Context, userID string, storyID string) (*progressdomain.ReadingProgress, error)
+	ListContinueReading(ctx context.Context, userID string, limit int) ([]*progressdomain.ReadingProgress, error)
+}
+
+// ReadingListDataProvider defines the interface for reading list operations
+type ReadingListDataProvider interface {
+	ListUserLists(ctx context.Context, userID string) ([]*readinglistdomain.ReadingList, error)
+	GetList(ctx context.Context, viewerID string, listID string) (*readinglistdomain.ReadingList, []*readinglistdomain.ReadingListItem, error)
+	CreateList(ctx context.Context, userID string, name string, visibility string) (*readinglistdomain.ReadingList, error)
+	UpdateList(ctx context.Context, userID string, listID string, name string, visibility string) (*readinglistdomain.ReadingList, error)
+	DeleteList(ctx context.Context, userID string, listID string) error
+	AddStory(ctx context.Context, userID string, listID string, storyID string) (*readinglistdomain.ReadingListItem, error)
+	RemoveStory(ctx context.Context, userID string, listID string, storyID string) error
+	Reorder(ctx context.Context, userID string, listID string, storyIDs []string) error
+	SaveStory(ctx context.Context, userID string, storyID string) (*readinglistdomain.ReadingListItem, error)
+	UnsaveStory(ctx context.Context, userID string, storyID string) error
+	IsSaved(ctx context.Context, userID string, storyID string) (bool, error)
+}
+
+// FollowDataProvider defines the interface for author follow operations
+type FollowDataProvider interface {
+	Follow(ctx context.Context, userID string, authorID string) error
+	Unfollow(ctx context.Context, userID string, authorID string) error
+	IsFollowing(ctx context.Context, userID string, authorID string) (bool, error)
+	ListFollowedAuthorIDs(ctx context.Context, userID string) ([]uint, error)
+}
+
+// NotificationDataProvider defines the interface for in-app notification operations
+type NotificationDataProvider interface {
+	ListNotifications(ctx context.Context, userID string, unreadOnly bool, limit, offset int) ([]*notificationdomain.Notification, error)
+	UnreadCount(ctx context.Context, userID string) (int64, error)
+	MarkRead(ctx context.Context, userID string, notificationID string) error
+	MarkAllRead(ctx context.Context, userID string) (int64, error)
 }
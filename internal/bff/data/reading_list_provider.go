@@ -0,0 +1,62 @@
+package data
+
+import (
+	"context"
+
+	readinglistdomain "go-monolith/internal/modules/readinglist/domain"
+	readingListModuleService "go-monolith/internal/modules/readinglist/service"
+)
+
+type ReadingListProvider struct {
+	readingListService *readingListModuleService.ReadingListService
+}
+
+func NewReadingListProvider(rs *readingListModuleService.ReadingListService) *ReadingListProvider {
+	return &ReadingListProvider{
+		readingListService: rs,
+	}
+}
+
+func (p *ReadingListProvider) ListUserLists(ctx context.Context, userID string) ([]*readinglistdomain.ReadingList, error) {
+	return p.readingListService.ListUserLists(ctx, userID)
+}
+
+func (p *ReadingListProvider) GetList(ctx context.Context, viewerID string, listID string) (*readinglistdomain.ReadingList, []*readinglistdomain.ReadingListItem, error) {
+	return p.readingListService.GetList(ctx, viewerID, listID)
+}
+
+func (p *ReadingListProvider) CreateList(ctx context.Context, userID string, name string, visibility string) (*readinglistdomain.ReadingList, error) {
+	return p.readingListService.CreateList(ctx, userID, name, visibility)
+}
+
+func (p *ReadingListProvider) UpdateList(ctx context.Context, userID string, listID string, name string, visibility string) (*readinglistdomain.ReadingList, error) {
+	return p.readingListService.UpdateList(ctx, userID, listID, name, visibility)
+}
+
+func (p *ReadingListProvider) DeleteList(ctx context.Context, userID string, listID string) error {
+	return p.readingListService.DeleteList(ctx, userID, listID)
+}
+
+func (p *ReadingListProvider) AddStory(ctx context.Context, userID string, listID string, storyID string) (*readinglistdomain.ReadingListItem, error) {
+	return p.readingListService.AddStory(ctx, userID, listID, storyID)
+}
+
+func (p *ReadingListProvider) RemoveStory(ctx context.Context, userID string, listID string, storyID string) error {
+	return p.readingListService.RemoveStory(ctx, userID, listID, storyID)
+}
+
+func (p *ReadingListProvider) Reorder(ctx context.Context, userID string, listID string, storyIDs []string) error {
+	return p.readingListService.Reorder(ctx, userID, listID, storyIDs)
+}
+
+func (p *ReadingListProvider) SaveStory(ctx context.Context, userID string, storyID string) (*readinglistdomain.ReadingListItem, error) {
+	return p.readingListService.SaveStory(ctx, userID, storyID)
+}
+
+func (p *ReadingListProvider) UnsaveStory(ctx context.Context, userID string, storyID string) error {
+	return p.readingListService.UnsaveStory(ctx, userID, storyID)
+}
+
+func (p *ReadingListProvider) IsSaved(ctx context.Context, userID string, storyID string) (bool, error) {
+	return p.readingListService.IsSaved(ctx, userID, storyID)
+}
@@ -20,3 +20,43 @@ func NewStoryProvider(sq *storyModuleService.StoryService) *StoryProvider {
 func (p *StoryProvider) GetStory(ctx context.Context, id string) (*storydomain.Story, error) {
 	return p.storyService.GetByID(ctx, id)
 }
+
+func (p *StoryProvider) LikeStory(ctx context.Context, id string, userID string) (*storydomain.Story, bool, error) {
+	return p.storyService.Like(ctx, id, userID)
+}
+
+func (p *StoryProvider) ListFeed(ctx context.Context, authorIDs []uint, after *storydomain.FeedCursor, limit int) ([]*storydomain.Story, error) {
+	return p.storyService.ListFeed(ctx, authorIDs, after, limit)
+}
+
+func (p *StoryProvider) ListTrending(ctx context.Context, limit, offset int) ([]*storydomain.Story, error) {
+	return p.storyService.ListTrending(ctx, limit, offset)
+}
+
+func (p *StoryProvider) GetStories(ctx context.Context, ids []uint) ([]*storydomain.Story, error) {
+	return p.storyService.GetByIDs(ctx, ids)
+}
+
+func (p *StoryProvider) ListStories(ctx context.Context, limit, offset int) ([]*storydomain.Story, error) {
+	return p.storyService.List(ctx, limit, offset)
+}
+
+func (p *StoryProvider) ListStoriesByAuthor(ctx context.Context, authorID string, includeDrafts bool, limit, offset int) ([]*storydomain.Story, error) {
+	return p.storyService.ListByAuthor(ctx, authorID, includeDrafts, limit, offset)
+}
+
+func (p *StoryProvider) CreateStory(ctx context.Context, title, content, authorID string) (*storydomain.Story, error) {
+	return p.storyService.Create(ctx, title, content, authorID)
+}
+
+func (p *StoryProvider) UpdateStory(ctx context.Context, id string, title, content string) (*storydomain.Story, error) {
+	return p.storyService.Update(ctx, id, title, content)
+}
+
+func (p *StoryProvider) PublishStory(ctx context.Context, id string) (*storydomain.Story, error) {
+	return p.storyService.Publish(ctx, id)
+}
+
+func (p *StoryProvider) DeleteStory(ctx context.Context, id string) error {
+	return p.storyService.Delete(ctx, id)
+}
@@ -0,0 +1,226 @@
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"reflect"
+	"time"
+
+	"go-monolith/internal/bff/service"
+	"go-monolith/pkg/logger"
+	"go-monolith/pkg/problem"
+)
+
+// Request is a GraphQL request as posted by clients
+type Request struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// Response is the result of a request. Data is absent when the request could not
+// be executed at all; a field that failed is null and has an entry in Errors.
+type Response struct {
+	Data   *object  `json:"data,omitempty"`
+	Errors []*Error `json:"errors,omitempty"`
+}
+
+// Error describes a failed request or field. Extensions carry the HTTP status the
+// error would be rendered with by the REST API.
+type Error struct {
+	Message    string                 `json:"message"`
+	Locations  []Location             `json:"locations,omitempty"`
+	Path       []interface{}          `json:"path,omitempty"`
+	Extensions map[string]interface{} `json:"extensions,omitempty"`
+}
+
+// newError describes err for clients as the REST API would, so internal messages
+// such as database errors are logged rather than returned
+func newError(ctx context.Context, err error) *Error {
+	p := problem.New(ctx, err)
+	return &Error{Message: p.Detail, Extensions: map[string]interface{}{"status": p.Status}}
+}
+
+// Location points at a field in the query
+type Location struct {
+	Line   int `json:"line"`
+	Column int `json:"column"`
+}
+
+// object is a result object. Its fields are kept in selection order.
+type object struct {
+	keys   []string
+	values map[string]interface{}
+}
+
+func newObject(size int) *object {
+	return &object{keys: make([]string, 0, size), values: make(map[string]interface{}, size)}
+}
+
+func (o *object) set(key string, value interface{}) {
+	o.keys = append(o.keys, key)
+	o.values[key] = value
+}
+
+func (o *object) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, key := range o.keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		name, err := json.Marshal(key)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(o.values[key])
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(name)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// request holds the state of one execution: its context, the errors so far, and the
+// author loader and story visibility shared by every resolver
+type request struct {
+	ctx        context.Context
+	schema     *Schema
+	authors    *authorLoader
+	visibility *service.StoryVisibility
+	errors     []*Error
+}
+
+// Execute parses, validates and resolves a request
+func (s *Schema) Execute(ctx context.Context, req Request) *Response {
+	start := time.Now()
+
+	op, err := parse(req.Query, req.OperationName)
+	if err != nil {
+		s.Metrics.IncrementCounter("graphql.query.rejected", []string{"reason:parse"})
+		return &Response{Errors: []*Error{parseError(ctx, err)}}
+	}
+	if errs := s.validate(op, req.Variables); len(errs) > 0 {
+		s.Metrics.IncrementCounter("graphql.query.rejected", []string{"reason:validation"})
+		return &Response{Errors: errs}
+	}
+
+	r := &request{
+		ctx:        ctx,
+		schema:     s,
+		authors:    newAuthorLoader(ctx, s.authorProvider),
+		visibility: service.NewStoryVisibility(s.authorProvider),
+	}
+	data := r.resolveObject(s.types["Query"], nil, op.selections, nil)
+
+	tags := []string{"operation:" + operationTag(op)}
+	if len(r.errors) > 0 {
+		s.Logger.Warn(ctx, "GraphQL query resolved with errors",
+			logger.String("operation", operationTag(op)),
+			logger.Int("errors", len(r.errors)),
+		)
+		s.Metrics.IncrementCounter("graphql.query.partial", tags)
+	} else {
+		s.Metrics.IncrementCounter("graphql.query.success", tags)
+	}
+	s.Metrics.RecordTiming("graphql.query.duration", time.Since(start), tags)
+
+	return &Response{Data: data, Errors: r.errors}
+}
+
+func parseError(ctx context.Context, err error) *Error {
+	if syntaxErr, ok := err.(*syntaxError); ok {
+		return &Error{
+			Message:   syntaxErr.Error(),
+			Locations: []Location{{Line: syntaxErr.line, Column: syntaxErr.column}},
+		}
+	}
+	return newError(ctx, err)
+}
+
+func operationTag(op *operation) string {
+	if op.name == "" {
+		return "anonymous"
+	}
+	return op.name
+}
+
+// resolveObject resolves the selections on source in order
+func (r *request) resolveObject(typ *objectType, source interface{}, selections []*field, path []interface{}) *object {
+	result := newObject(len(selections))
+	for _, f := range selections {
+		key := f.responseKey()
+		result.set(key, r.resolveField(typ.fields[f.name], source, f, appendPath(path, key)))
+	}
+	return result
+}
+
+// resolveField resolves one field. A failed field is recorded as an error and is null.
+func (r *request) resolveField(def *fieldDef, source interface{}, f *field, path []interface{}) interface{} {
+	value, err := def.resolve(r, source, f.values)
+	if err != nil {
+		fieldErr := newError(r.ctx, err)
+		fieldErr.Locations = []Location{{Line: f.line, Column: f.column}}
+		fieldErr.Path = path
+		r.errors = append(r.errors, fieldErr)
+		return nil
+	}
+	if isNil(value) {
+		return nil
+	}
+	if isScalar(def.typeName) {
+		return value
+	}
+
+	typ := r.schema.types[def.typeName]
+	if !def.list {
+		return r.resolveObject(typ, value, f.selections, path)
+	}
+
+	items := listItems(value)
+	if typ.prefetch != nil && len(items) > 0 {
+		typ.prefetch(r, items, f.selections)
+	}
+	results := make([]interface{}, len(items))
+	for i, item := range items {
+		results[i] = r.resolveObject(typ, item, f.selections, appendPath(path, i))
+	}
+	return results
+}
+
+// appendPath returns a copy of path extended with element, so sibling paths never share storage
+func appendPath(path []interface{}, element interface{}) []interface{} {
+	extended := make([]interface{}, len(path), len(path)+1)
+	copy(extended, path)
+	return append(extended, element)
+}
+
+// listItems returns the elements of a slice returned by a list resolver
+func listItems(value interface{}) []interface{} {
+	list := reflect.ValueOf(value)
+	items := make([]interface{}, 0, list.Len())
+	for i := 0; i < list.Len(); i++ {
+		if item := list.Index(i).Interface(); !isNil(item) {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// isNil reports whether value is nil, including a nil pointer held in an interface
+func isNil(value interface{}) bool {
+	if value == nil {
+		return true
+	}
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Slice, reflect.Map, reflect.Interface:
+		return v.IsNil()
+	}
+	return false
+}
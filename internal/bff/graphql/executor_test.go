@@ -0,0 +1,149 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	data "go-monolith/internal/bff/data"
+	authordomain "go-monolith/internal/modules/author/domain"
+	storydomain "go-monolith/internal/modules/story/domain"
+	"go-monolith/pkg/errors"
+)
+
+// fakeStories serves stories from memory. Methods the schema does not call are
+// left to the embedded interface.
+type fakeStories struct {
+	data.StoryDataProvider
+	stories map[string]*storydomain.Story
+	err     error
+}
+
+func (f *fakeStories) GetStory(_ context.Context, id string) (*storydomain.Story, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	story, ok := f.stories[id]
+	if !ok {
+		return nil, errors.NewNotFoundError("story", id)
+	}
+	return story, nil
+}
+
+// fakeAuthors serves authors from memory and counts batch lookups
+type fakeAuthors struct {
+	data.AuthorDataProvider
+	authors map[uint]*authordomain.Author
+	lookups int
+}
+
+func (f *fakeAuthors) GetAuthors(_ context.Context, ids []uint) ([]*authordomain.Author, error) {
+	f.lookups++
+	var authors []*authordomain.Author
+	for _, id := range ids {
+		if author, ok := f.authors[id]; ok {
+			authors = append(authors, author)
+		}
+	}
+	return authors, nil
+}
+
+func newTestData() (*fakeStories, *fakeAuthors) {
+	published := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	stories := &fakeStories{stories: map[string]*storydomain.Story{
+		"1": {ID: 1, Title: "The Sea", AuthorID: 3, PublishedAt: &published},
+		"2": {ID: 2, Title: "The Sky", AuthorID: 4, PublishedAt: &published},
+		"5": {ID: 5, Title: "Draft", AuthorID: 3},
+	}}
+	authors := &fakeAuthors{authors: map[uint]*authordomain.Author{
+		3: {ID: 3, FirstName: "Jane", LastName: "Doe", Slug: "jane-doe-author"},
+	}}
+	return stories, authors
+}
+
+func execute(t *testing.T, s *Schema, query string) (string, *Response) {
+	t.Helper()
+	resp := s.Execute(context.Background(), Request{Query: query})
+	body, err := json.Marshal(resp)
+	if err != nil {
+		t.Fatalf("marshal response: %v", err)
+	}
+	return string(body), resp
+}
+
+func TestExecuteFragments(t *testing.T) {
+	body, _ := execute(t, newTestSchema(newTestData()), `
+		{ first: story(id: 1) { ...StoryFields } }
+		fragment StoryFields on Story { title id author { ... on Author { name } } }`)
+
+	want := `{"data":{"first":{"title":"The Sea","id":"1","author":{"name":"Jane Doe"}}}}`
+	if body != want {
+		t.Errorf("response = %s, want %s", body, want)
+	}
+}
+
+func TestExecuteFieldErrors(t *testing.T) {
+	stories, authors := newTestData()
+	body, resp := execute(t, newTestSchema(stories, authors), `{ story(id: 9) { id } other: story(id: 2) { title author { name } } }`)
+
+	if len(resp.Errors) != 2 {
+		t.Fatalf("response = %s, want 2 errors", body)
+	}
+	notFound := resp.Errors[0]
+	if notFound.Message != "story not found: 9" || notFound.Extensions["status"] != http.StatusNotFound {
+		t.Errorf("first error = %+v", notFound)
+	}
+	if fmt.Sprint(notFound.Path) != "[story]" {
+		t.Errorf("first error path = %v, want [story]", notFound.Path)
+	}
+	if missing := resp.Errors[1]; fmt.Sprint(missing.Path) != "[other author]" {
+		t.Errorf("second error path = %v, want [other author]", missing.Path)
+	}
+	if !strings.Contains(body, `"story":null`) || !strings.Contains(body, `"title":"The Sky","author":null`) {
+		t.Errorf("response = %s, want the failed fields null", body)
+	}
+}
+
+func TestExecuteHidesDrafts(t *testing.T) {
+	body, resp := execute(t, newTestSchema(newTestData()), `{ story(id: 5) { title } }`)
+
+	if strings.Contains(body, "Draft") {
+		t.Errorf("response leaks the draft: %s", body)
+	}
+	if len(resp.Errors) != 1 || resp.Errors[0].Message != "story not found: 5" ||
+		resp.Errors[0].Extensions["status"] != http.StatusNotFound {
+		t.Errorf("response = %s, want the draft reported as not found", body)
+	}
+}
+
+func TestExecuteHidesInternalErrors(t *testing.T) {
+	stories, authors := newTestData()
+	stories.err = errors.NewUnexpectedError(fmt.Errorf("dial tcp 10.0.0.5:3306: connection refused"))
+	body, resp := execute(t, newTestSchema(stories, authors), `{ story(id: 1) { id } }`)
+
+	if strings.Contains(body, "3306") || strings.Contains(body, "dial tcp") {
+		t.Errorf("response leaks the internal error: %s", body)
+	}
+	if len(resp.Errors) != 1 || resp.Errors[0].Message != "the request could not be completed" ||
+		resp.Errors[0].Extensions["status"] != http.StatusInternalServerError {
+		t.Errorf("response = %s, want a generic internal error", body)
+	}
+}
+
+func TestExecuteRejectsInvalidQueries(t *testing.T) {
+	s := newTestSchema(newTestData())
+
+	_, resp := execute(t, s, `{ story(id: 1) { id }`)
+	if resp.Data != nil || len(resp.Errors) != 1 || len(resp.Errors[0].Locations) != 1 {
+		t.Errorf("syntax error response = %+v", resp)
+	}
+
+	_, resp = execute(t, s, `query A { stories { id } } query B { stories { id } }`)
+	if resp.Data != nil || len(resp.Errors) != 1 || resp.Errors[0].Extensions["status"] != http.StatusBadRequest {
+		t.Errorf("ambiguous operation response = %+v", resp)
+	}
+}
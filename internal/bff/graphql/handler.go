@@ -0,0 +1,53 @@
+package graphql
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Handler struct {
+	schema *Schema
+}
+
+var handler *Handler
+
+func NewHandler(s *Schema) *Handler {
+	if handler == nil {
+		handler = &Handler{
+			schema: s,
+		}
+	}
+	return handler
+}
+
+// Query handles POST /graphql with a body of {"query", "operationName", "variables"}.
+// Requests that cannot be executed respond 400; once execution starts the response
+// is 200 and failed fields are listed in errors.
+func (h *Handler) Query(c *gin.Context) {
+	var req Request
+	body, err := c.GetRawData()
+	if err == nil {
+		// Numbers are kept exact so Int and ID variables are not rounded through float64
+		decoder := json.NewDecoder(bytes.NewReader(body))
+		decoder.UseNumber()
+		err = decoder.Decode(&req)
+	}
+	if err != nil {
+		c.JSON(http.StatusBadRequest, Response{Errors: []*Error{{Message: "invalid request body"}}})
+		return
+	}
+	if req.Query == "" {
+		c.JSON(http.StatusBadRequest, Response{Errors: []*Error{{Message: "query is required"}}})
+		return
+	}
+
+	resp := h.schema.Execute(c.Request.Context(), req)
+	if resp.Data == nil {
+		c.JSON(http.StatusBadRequest, resp)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,83 @@
+package graphql
+
+import (
+	"context"
+	"strconv"
+
+	data "go-monolith/internal/bff/data"
+	authordomain "go-monolith/internal/modules/author/domain"
+	"go-monolith/pkg/errors"
+)
+
+// authorLoader caches the authors looked up during one request. Lists prefetch the
+// authors they need in a single batch, so resolving the author of each story does
+// not cost a lookup per story.
+type authorLoader struct {
+	ctx      context.Context
+	provider data.AuthorDataProvider
+	authors  map[uint]*authordomain.Author
+	failures map[uint]error
+}
+
+func newAuthorLoader(ctx context.Context, provider data.AuthorDataProvider) *authorLoader {
+	return &authorLoader{
+		ctx:      ctx,
+		provider: provider,
+		authors:  map[uint]*authordomain.Author{},
+		failures: map[uint]error{},
+	}
+}
+
+// load returns the author with the given ID, looking it up unless it was loaded before
+func (l *authorLoader) load(id uint) (*authordomain.Author, error) {
+	l.loadMany([]uint{id})
+	if author, ok := l.authors[id]; ok {
+		return author, nil
+	}
+	return nil, l.failures[id]
+}
+
+// loadMany looks up every author not loaded yet in one batch. Failures are kept
+// per author and reported when the author is resolved.
+func (l *authorLoader) loadMany(ids []uint) {
+	missing := make([]uint, 0, len(ids))
+	seen := map[uint]bool{}
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		if _, ok := l.authors[id]; ok {
+			continue
+		}
+		if _, ok := l.failures[id]; ok {
+			continue
+		}
+		missing = append(missing, id)
+	}
+	if len(missing) == 0 {
+		return
+	}
+
+	authors, err := l.provider.GetAuthors(l.ctx, missing)
+	if err != nil {
+		for _, id := range missing {
+			l.failures[id] = err
+		}
+		return
+	}
+	for _, author := range authors {
+		l.prime(author)
+	}
+	for _, id := range missing {
+		if _, ok := l.authors[id]; !ok {
+			l.failures[id] = errors.NewNotFoundError("author", strconv.FormatUint(uint64(id), 10))
+		}
+	}
+}
+
+// prime adds an author loaded elsewhere
+func (l *authorLoader) prime(author *authordomain.Author) {
+	l.authors[author.ID] = author
+	delete(l.failures, author.ID)
+}
@@ -0,0 +1,649 @@
+package graphql
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"go-monolith/pkg/errors"
+)
+
+// This file parses the subset of the GraphQL query language the BFF supports:
+// query operations with variables, aliases, arguments, nested selections and
+// named or inline fragments. Directives and mutations are rejected.
+
+// operation is a parsed query operation. Fragments are those defined in the
+// document, which the validator expands into the operation's selections.
+type operation struct {
+	name       string
+	variables  []*variableDefinition
+	selections []*field
+	fragments  map[string]*fragmentDefinition
+}
+
+// fragmentDefinition is a named fragment, selecting fields on typeCondition
+type fragmentDefinition struct {
+	name          string
+	typeCondition string
+	selections    []*field
+	line          int
+	column        int
+}
+
+type variableDefinition struct {
+	name         string
+	typeName     string
+	nonNull      bool
+	defaultValue interface{}
+}
+
+// field is a selected field. Arguments hold literals or variable references.
+// A fragment spread or inline fragment is parsed as a field with fragment set
+// and replaced by the fields it selects during validation.
+type field struct {
+	alias      string
+	name       string
+	arguments  map[string]interface{}
+	selections []*field
+	fragment   *fragmentSpread
+	line       int
+	column     int
+
+	// values holds the coerced arguments, see validate
+	values map[string]interface{}
+}
+
+// fragmentSpread spreads the named fragment or, when name is empty, the inline
+// fragment's own selections. typeCondition is empty for an inline fragment
+// without one, which applies to any type.
+type fragmentSpread struct {
+	name          string
+	typeCondition string
+}
+
+// responseKey is the name the field's value is returned under
+func (f *field) responseKey() string {
+	if f.alias != "" {
+		return f.alias
+	}
+	return f.name
+}
+
+// variableRef refers to a request variable from an argument
+type variableRef struct {
+	name string
+}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenPunct
+	tokenName
+	tokenInt
+	tokenFloat
+	tokenString
+)
+
+type token struct {
+	kind   tokenKind
+	value  string
+	line   int
+	column int
+}
+
+// syntaxError reports a malformed query
+type syntaxError struct {
+	message string
+	line    int
+	column  int
+}
+
+func (e *syntaxError) Error() string {
+	return fmt.Sprintf("syntax error at %d:%d: %s", e.line, e.column, e.message)
+}
+
+type lexer struct {
+	input  string
+	pos    int
+	line   int
+	column int
+}
+
+func (l *lexer) next() (token, error) {
+	l.skipIgnored()
+	start := token{line: l.line, column: l.column}
+	if l.pos >= len(l.input) {
+		start.kind = tokenEOF
+		return start, nil
+	}
+
+	c := l.input[l.pos]
+	switch {
+	case strings.IndexByte("{}()[]:$!=,@", c) >= 0:
+		l.advance(1)
+		start.kind, start.value = tokenPunct, string(c)
+		return start, nil
+	case c == '.':
+		if strings.HasPrefix(l.input[l.pos:], "...") {
+			l.advance(3)
+			start.kind, start.value = tokenPunct, "..."
+			return start, nil
+		}
+	case c == '_' || isLetter(c):
+		begin := l.pos
+		for l.pos < len(l.input) && (l.input[l.pos] == '_' || isLetter(l.input[l.pos]) || isDigit(l.input[l.pos])) {
+			l.advance(1)
+		}
+		start.kind, start.value = tokenName, l.input[begin:l.pos]
+		return start, nil
+	case c == '-' || isDigit(c):
+		return l.number(start)
+	case c == '"':
+		return l.string(start)
+	}
+	return start, &syntaxError{message: fmt.Sprintf("unexpected character %q", c), line: l.line, column: l.column}
+}
+
+// skipIgnored skips whitespace, commas and comments, which carry no meaning
+func (l *lexer) skipIgnored() {
+	for l.pos < len(l.input) {
+		switch c := l.input[l.pos]; {
+		case c == '\n':
+			l.pos++
+			l.line++
+			l.column = 1
+		case c == ' ' || c == '\t' || c == '\r' || c == ',':
+			l.advance(1)
+		case c == '#':
+			for l.pos < len(l.input) && l.input[l.pos] != '\n' {
+				l.advance(1)
+			}
+		default:
+			return
+		}
+	}
+}
+
+func (l *lexer) number(start token) (token, error) {
+	begin := l.pos
+	if l.input[l.pos] == '-' {
+		l.advance(1)
+	}
+	start.kind = tokenInt
+	for l.pos < len(l.input) {
+		c := l.input[l.pos]
+		switch {
+		case isDigit(c):
+		case c == '.' || c == 'e' || c == 'E' || ((c == '+' || c == '-') && start.kind == tokenFloat):
+			start.kind = tokenFloat
+		default:
+			start.value = l.input[begin:l.pos]
+			return start, nil
+		}
+		l.advance(1)
+	}
+	start.value = l.input[begin:l.pos]
+	return start, nil
+}
+
+func (l *lexer) string(start token) (token, error) {
+	l.advance(1)
+	var b strings.Builder
+	for l.pos < len(l.input) {
+		c := l.input[l.pos]
+		switch c {
+		case '"':
+			l.advance(1)
+			start.kind, start.value = tokenString, b.String()
+			return start, nil
+		case '\n':
+			return start, &syntaxError{message: "unterminated string", line: start.line, column: start.column}
+		case '\\':
+			if l.pos+1 >= len(l.input) {
+				break
+			}
+			escaped := l.input[l.pos+1]
+			switch escaped {
+			case '"', '\\', '/':
+				b.WriteByte(escaped)
+			case 'n':
+				b.WriteByte('\n')
+			case 't':
+				b.WriteByte('\t')
+			default:
+				return start, &syntaxError{message: fmt.Sprintf("unsupported escape \\%c", escaped), line: l.line, column: l.column}
+			}
+			l.advance(2)
+			continue
+		default:
+			b.WriteByte(c)
+		}
+		l.advance(1)
+	}
+	return start, &syntaxError{message: "unterminated string", line: start.line, column: start.column}
+}
+
+func (l *lexer) advance(n int) {
+	l.pos += n
+	l.column += n
+}
+
+func isLetter(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// parser is a recursive descent parser over the lexer's tokens
+type parser struct {
+	lexer *lexer
+	token token
+}
+
+// parse returns the operation to execute. With several operations in the
+// document, operationName selects one of them.
+func parse(query, operationName string) (*operation, error) {
+	p := &parser{lexer: &lexer{input: query, line: 1, column: 1}}
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+
+	var operations []*operation
+	fragments := map[string]*fragmentDefinition{}
+	for p.token.kind != tokenEOF {
+		if p.token.kind == tokenName && p.token.value == "fragment" {
+			fragment, err := p.parseFragmentDefinition()
+			if err != nil {
+				return nil, err
+			}
+			if _, ok := fragments[fragment.name]; ok {
+				return nil, &syntaxError{message: fmt.Sprintf("fragment %q is defined twice", fragment.name), line: fragment.line, column: fragment.column}
+			}
+			fragments[fragment.name] = fragment
+			continue
+		}
+		op, err := p.parseOperation()
+		if err != nil {
+			return nil, err
+		}
+		op.fragments = fragments
+		operations = append(operations, op)
+	}
+
+	switch {
+	case len(operations) == 0:
+		return nil, errors.NewValidationError("query contains no operation")
+	case operationName == "" && len(operations) == 1:
+		return operations[0], nil
+	case operationName == "":
+		return nil, errors.NewValidationError("operationName is required when the query contains several operations")
+	}
+	for _, op := range operations {
+		if op.name == operationName {
+			return op, nil
+		}
+	}
+	return nil, errors.NewValidationError(fmt.Sprintf("unknown operation %q", operationName))
+}
+
+func (p *parser) advance() error {
+	tok, err := p.lexer.next()
+	if err != nil {
+		return err
+	}
+	p.token = tok
+	return nil
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return &syntaxError{message: fmt.Sprintf(format, args...), line: p.token.line, column: p.token.column}
+}
+
+func (p *parser) expect(punct string) error {
+	if p.token.kind != tokenPunct || p.token.value != punct {
+		return p.errorf("expected %q", punct)
+	}
+	return p.advance()
+}
+
+func (p *parser) peek(punct string) bool {
+	return p.token.kind == tokenPunct && p.token.value == punct
+}
+
+func (p *parser) parseOperation() (*operation, error) {
+	op := &operation{}
+	if p.peek("{") {
+		selections, err := p.parseSelectionSet()
+		if err != nil {
+			return nil, err
+		}
+		op.selections = selections
+		return op, nil
+	}
+
+	if p.token.kind != tokenName {
+		return nil, p.errorf("expected an operation")
+	}
+	switch p.token.value {
+	case "query":
+	case "mutation", "subscription":
+		return nil, p.errorf("%s operations are not supported", p.token.value)
+	default:
+		return nil, p.errorf("unexpected %q", p.token.value)
+	}
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+
+	if p.token.kind == tokenName {
+		op.name = p.token.value
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+	}
+	if p.peek("(") {
+		variables, err := p.parseVariableDefinitions()
+		if err != nil {
+			return nil, err
+		}
+		op.variables = variables
+	}
+	if p.peek("@") {
+		return nil, p.errorf("directives are not supported")
+	}
+
+	selections, err := p.parseSelectionSet()
+	if err != nil {
+		return nil, err
+	}
+	op.selections = selections
+	return op, nil
+}
+
+// parseFragmentDefinition parses fragment Name on Type { ... }
+func (p *parser) parseFragmentDefinition() (*fragmentDefinition, error) {
+	fragment := &fragmentDefinition{line: p.token.line, column: p.token.column}
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+	if p.token.kind != tokenName || p.token.value == "on" {
+		return nil, p.errorf("expected a fragment name")
+	}
+	fragment.name = p.token.value
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+
+	typeCondition, err := p.parseTypeCondition()
+	if err != nil {
+		return nil, err
+	}
+	if typeCondition == "" {
+		return nil, p.errorf("expected a type condition on fragment %q", fragment.name)
+	}
+	fragment.typeCondition = typeCondition
+	if p.peek("@") {
+		return nil, p.errorf("directives are not supported")
+	}
+
+	selections, err := p.parseSelectionSet()
+	if err != nil {
+		return nil, err
+	}
+	fragment.selections = selections
+	return fragment, nil
+}
+
+// parseTypeCondition parses "on Type", returning an empty name when there is none
+func (p *parser) parseTypeCondition() (string, error) {
+	if p.token.kind != tokenName || p.token.value != "on" {
+		return "", nil
+	}
+	if err := p.advance(); err != nil {
+		return "", err
+	}
+	if p.token.kind != tokenName {
+		return "", p.errorf("expected a type name")
+	}
+	typeName := p.token.value
+	return typeName, p.advance()
+}
+
+func (p *parser) parseVariableDefinitions() ([]*variableDefinition, error) {
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+	var definitions []*variableDefinition
+	for !p.peek(")") {
+		if err := p.expect("$"); err != nil {
+			return nil, err
+		}
+		if p.token.kind != tokenName {
+			return nil, p.errorf("expected a variable name")
+		}
+		definition := &variableDefinition{name: p.token.value}
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		if err := p.expect(":"); err != nil {
+			return nil, err
+		}
+		if p.token.kind != tokenName {
+			return nil, p.errorf("expected a variable type")
+		}
+		definition.typeName = p.token.value
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		if p.peek("!") {
+			definition.nonNull = true
+			if err := p.advance(); err != nil {
+				return nil, err
+			}
+		}
+		if p.peek("=") {
+			if err := p.advance(); err != nil {
+				return nil, err
+			}
+			value, err := p.parseValue(true)
+			if err != nil {
+				return nil, err
+			}
+			definition.defaultValue = value
+		}
+		definitions = append(definitions, definition)
+	}
+	return definitions, p.expect(")")
+}
+
+func (p *parser) parseSelectionSet() ([]*field, error) {
+	if err := p.expect("{"); err != nil {
+		return nil, err
+	}
+	var selections []*field
+	for !p.peek("}") {
+		if p.token.kind == tokenEOF {
+			return nil, p.errorf("unterminated selection set")
+		}
+		parse := p.parseField
+		if p.peek("...") {
+			parse = p.parseFragment
+		}
+		f, err := parse()
+		if err != nil {
+			return nil, err
+		}
+		selections = append(selections, f)
+	}
+	if len(selections) == 0 {
+		return nil, p.errorf("selection set cannot be empty")
+	}
+	return selections, p.advance()
+}
+
+// parseFragment parses a fragment spread, ...Name, or an inline fragment,
+// ... on Type { ... } where the type condition is optional
+func (p *parser) parseFragment() (*field, error) {
+	f := &field{fragment: &fragmentSpread{}, line: p.token.line, column: p.token.column}
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+
+	if p.token.kind == tokenName && p.token.value != "on" {
+		f.fragment.name = p.token.value
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		if p.peek("@") {
+			return nil, p.errorf("directives are not supported")
+		}
+		return f, nil
+	}
+
+	typeCondition, err := p.parseTypeCondition()
+	if err != nil {
+		return nil, err
+	}
+	f.fragment.typeCondition = typeCondition
+	if p.peek("@") {
+		return nil, p.errorf("directives are not supported")
+	}
+	selections, err := p.parseSelectionSet()
+	if err != nil {
+		return nil, err
+	}
+	f.selections = selections
+	return f, nil
+}
+
+func (p *parser) parseField() (*field, error) {
+	if p.token.kind != tokenName {
+		return nil, p.errorf("expected a field name")
+	}
+	f := &field{name: p.token.value, line: p.token.line, column: p.token.column}
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+
+	if p.peek(":") {
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		if p.token.kind != tokenName {
+			return nil, p.errorf("expected a field name after alias %q", f.name)
+		}
+		f.alias, f.name = f.name, p.token.value
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+	}
+
+	if p.peek("(") {
+		arguments, err := p.parseArguments()
+		if err != nil {
+			return nil, err
+		}
+		f.arguments = arguments
+	}
+	if p.peek("@") {
+		return nil, p.errorf("directives are not supported")
+	}
+	if p.peek("{") {
+		selections, err := p.parseSelectionSet()
+		if err != nil {
+			return nil, err
+		}
+		f.selections = selections
+	}
+	return f, nil
+}
+
+func (p *parser) parseArguments() (map[string]interface{}, error) {
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+	arguments := map[string]interface{}{}
+	for !p.peek(")") {
+		if p.token.kind != tokenName {
+			return nil, p.errorf("expected an argument name")
+		}
+		name := p.token.value
+		if _, ok := arguments[name]; ok {
+			return nil, p.errorf("argument %q given twice", name)
+		}
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		if err := p.expect(":"); err != nil {
+			return nil, err
+		}
+		value, err := p.parseValue(false)
+		if err != nil {
+			return nil, err
+		}
+		arguments[name] = value
+	}
+	return arguments, p.expect(")")
+}
+
+// parseValue parses an argument or default value. Constant values cannot refer to variables.
+func (p *parser) parseValue(constant bool) (interface{}, error) {
+	tok := p.token
+	switch tok.kind {
+	case tokenPunct:
+		if tok.value == "$" && !constant {
+			if err := p.advance(); err != nil {
+				return nil, err
+			}
+			if p.token.kind != tokenName {
+				return nil, p.errorf("expected a variable name")
+			}
+			ref := variableRef{name: p.token.value}
+			return ref, p.advance()
+		}
+		if tok.value == "[" {
+			if err := p.advance(); err != nil {
+				return nil, err
+			}
+			var values []interface{}
+			for !p.peek("]") {
+				if p.token.kind == tokenEOF {
+					return nil, p.errorf("unterminated list")
+				}
+				value, err := p.parseValue(constant)
+				if err != nil {
+					return nil, err
+				}
+				values = append(values, value)
+			}
+			return values, p.advance()
+		}
+	case tokenInt:
+		value, err := strconv.ParseInt(tok.value, 10, 64)
+		if err != nil {
+			return nil, p.errorf("invalid integer %q", tok.value)
+		}
+		return value, p.advance()
+	case tokenFloat:
+		value, err := strconv.ParseFloat(tok.value, 64)
+		if err != nil {
+			return nil, p.errorf("invalid number %q", tok.value)
+		}
+		return value, p.advance()
+	case tokenString:
+		return tok.value, p.advance()
+	case tokenName:
+		switch tok.value {
+		case "true":
+			return true, p.advance()
+		case "false":
+			return false, p.advance()
+		case "null":
+			return nil, p.advance()
+		}
+	}
+	return nil, p.errorf("unexpected value")
+}
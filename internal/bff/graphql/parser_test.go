@@ -0,0 +1,170 @@
+package graphql
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	op, err := parse(`
+		# comments and commas are ignored
+		query Feed($limit: Int = 5, $author: ID!) {
+			latest: stories(limit: $limit, offset: 0) { id, title }
+			author(id: $author) { name }
+		}`, "")
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+
+	if op.name != "Feed" {
+		t.Errorf("name = %q, want Feed", op.name)
+	}
+	if len(op.variables) != 2 {
+		t.Fatalf("got %d variables, want 2", len(op.variables))
+	}
+	if limit := op.variables[0]; limit.name != "limit" || limit.typeName != typeInt || limit.nonNull || limit.defaultValue != int64(5) {
+		t.Errorf("limit variable = %+v", limit)
+	}
+	if author := op.variables[1]; author.name != "author" || author.typeName != typeID || !author.nonNull {
+		t.Errorf("author variable = %+v", author)
+	}
+
+	if len(op.selections) != 2 {
+		t.Fatalf("got %d selections, want 2", len(op.selections))
+	}
+	latest := op.selections[0]
+	if latest.alias != "latest" || latest.name != "stories" || latest.responseKey() != "latest" {
+		t.Errorf("latest = alias %q name %q", latest.alias, latest.name)
+	}
+	wantArguments := map[string]interface{}{"limit": variableRef{name: "limit"}, "offset": int64(0)}
+	if !reflect.DeepEqual(latest.arguments, wantArguments) {
+		t.Errorf("arguments = %v, want %v", latest.arguments, wantArguments)
+	}
+	if got := fieldNames(latest.selections); !reflect.DeepEqual(got, []string{"id", "title"}) {
+		t.Errorf("latest selections = %v", got)
+	}
+	if latest.line != 4 || latest.column != 4 {
+		t.Errorf("latest location = %d:%d, want 4:4", latest.line, latest.column)
+	}
+}
+
+func TestParseValues(t *testing.T) {
+	op, err := parse(`{ story(id: "a\"b", ids: [1, 2], ratio: -1.5, on: true, off: false, none: null) { id } }`, "")
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	want := map[string]interface{}{
+		"id":    `a"b`,
+		"ids":   []interface{}{int64(1), int64(2)},
+		"ratio": -1.5,
+		"on":    true,
+		"off":   false,
+		"none":  nil,
+	}
+	if got := op.selections[0].arguments; !reflect.DeepEqual(got, want) {
+		t.Errorf("arguments = %v, want %v", got, want)
+	}
+}
+
+func TestParseFragments(t *testing.T) {
+	op, err := parse(`
+		query { story(id: 1) { ...StoryFields ... on Story { views } ... { likes } } }
+		fragment StoryFields on Story { id title }`, "")
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+
+	fragment, ok := op.fragments["StoryFields"]
+	if !ok {
+		t.Fatalf("fragment StoryFields not parsed, got %v", op.fragments)
+	}
+	if fragment.typeCondition != "Story" || !reflect.DeepEqual(fieldNames(fragment.selections), []string{"id", "title"}) {
+		t.Errorf("fragment = on %s selecting %v", fragment.typeCondition, fieldNames(fragment.selections))
+	}
+
+	selections := op.selections[0].selections
+	if len(selections) != 3 {
+		t.Fatalf("got %d selections, want 3", len(selections))
+	}
+	if spread := selections[0].fragment; spread == nil || spread.name != "StoryFields" {
+		t.Errorf("first selection = %+v, want a spread of StoryFields", selections[0])
+	}
+	if inline := selections[1].fragment; inline == nil || inline.name != "" || inline.typeCondition != "Story" {
+		t.Errorf("second selection = %+v, want an inline fragment on Story", selections[1])
+	}
+	if inline := selections[2].fragment; inline == nil || inline.typeCondition != "" {
+		t.Errorf("third selection = %+v, want an inline fragment without type condition", selections[2])
+	}
+}
+
+func TestParseOperationName(t *testing.T) {
+	query := `query A { stories { id } } query B { stories { title } }`
+
+	op, err := parse(query, "B")
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if op.name != "B" {
+		t.Errorf("selected operation %q, want B", op.name)
+	}
+
+	if _, err := parse(query, ""); err == nil || !strings.Contains(err.Error(), "operationName is required") {
+		t.Errorf("without operationName: err = %v", err)
+	}
+	if _, err := parse(query, "C"); err == nil || !strings.Contains(err.Error(), `unknown operation "C"`) {
+		t.Errorf("with unknown operationName: err = %v", err)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  string
+	}{
+		{"empty document", ``, "query contains no operation"},
+		{"mutation", `mutation { stories { id } }`, "mutation operations are not supported"},
+		{"field directive", `{ stories @include(if: true) { id } }`, "directives are not supported"},
+		{"spread directive", `{ stories { ...F @skip(if: true) } } fragment F on Story { id }`, "directives are not supported"},
+		{"unterminated selection", `{ stories { id }`, "unterminated selection set"},
+		{"empty selection", `{ stories { } }`, "selection set cannot be empty"},
+		{"duplicate argument", `{ story(id: 1, id: 2) { id } }`, `argument "id" given twice`},
+		{"unterminated string", `{ story(id: "1) { id } }`, "unterminated string"},
+		{"unexpected character", `{ stories { id % } }`, "unexpected character"},
+		{"variable in default", `query($a: Int = $b) { stories { id } }`, "unexpected value"},
+		{"fragment without type", `{ stories { ...F } } fragment F { id }`, `expected a type condition on fragment "F"`},
+		{"fragment named on", `{ stories { id } } fragment on on Story { id }`, "expected a fragment name"},
+		{"duplicate fragment", `{ stories { ...F } } fragment F on Story { id } fragment F on Story { title }`, `fragment "F" is defined twice`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parse(tt.query, "")
+			if err == nil {
+				t.Fatalf("parse succeeded, want error containing %q", tt.want)
+			}
+			if !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("err = %q, want it to contain %q", err.Error(), tt.want)
+			}
+		})
+	}
+}
+
+func TestParseErrorLocation(t *testing.T) {
+	_, err := parse("{\n  stories {\n    id @deprecated\n  }\n}", "")
+	syntaxErr, ok := err.(*syntaxError)
+	if !ok {
+		t.Fatalf("err = %v, want a syntax error", err)
+	}
+	if syntaxErr.line != 3 || syntaxErr.column != 8 {
+		t.Errorf("location = %d:%d, want 3:8", syntaxErr.line, syntaxErr.column)
+	}
+}
+
+func fieldNames(selections []*field) []string {
+	names := make([]string, len(selections))
+	for i, f := range selections {
+		names[i] = f.responseKey()
+	}
+	return names
+}
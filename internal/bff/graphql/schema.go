@@ -0,0 +1,271 @@
+package graphql
+
+import (
+	"strconv"
+	"time"
+
+	data "go-monolith/internal/bff/data"
+	"go-monolith/internal/bff/handler/builder"
+	authordomain "go-monolith/internal/modules/author/domain"
+	storydomain "go-monolith/internal/modules/story/domain"
+	"go-monolith/pkg/errors"
+	"go-monolith/pkg/logger"
+	"go-monolith/pkg/metrics"
+)
+
+// Query limits. Depth counts nested object selections, complexity counts resolved
+// fields with list fields weighted by their limit. Selections count the fields a
+// query selects once its fragments are expanded, before they are merged.
+const (
+	MaxDepth      = 5
+	MaxComplexity = 500
+	MaxSelections = 1000
+
+	defaultListLimit = 10
+	maxListLimit     = 50
+)
+
+// Scalar type names
+const (
+	typeID       = "ID"
+	typeString   = "String"
+	typeInt      = "Int"
+	typeBoolean  = "Boolean"
+	typeDateTime = "DateTime"
+)
+
+// argumentDef declares an argument a field accepts
+type argumentDef struct {
+	typeName     string
+	nonNull      bool
+	defaultValue interface{}
+}
+
+// resolveFunc returns the value of a field on source, which is nil for root fields
+type resolveFunc func(r *request, source interface{}, args map[string]interface{}) (interface{}, error)
+
+// fieldDef declares a field of an object type. typeName is a scalar or an object type.
+type fieldDef struct {
+	typeName  string
+	list      bool
+	arguments map[string]argumentDef
+	resolve   resolveFunc
+}
+
+// objectType declares an object type. prefetch, when set, is given every object of a
+// list before their fields are resolved so lookups can be batched.
+type objectType struct {
+	name     string
+	fields   map[string]*fieldDef
+	prefetch func(r *request, sources []interface{}, selections []*field)
+}
+
+// Schema exposes stories and authors over the BFF data providers
+type Schema struct {
+	storyProvider  data.StoryDataProvider
+	authorProvider data.AuthorDataProvider
+	types          map[string]*objectType
+	Logger         logger.Logger
+	Metrics        *metrics.Client
+}
+
+var schema *Schema
+
+func NewSchema(sp data.StoryDataProvider, ap data.AuthorDataProvider, log logger.Logger, metrics *metrics.Client) *Schema {
+	if schema == nil {
+		schema = &Schema{
+			storyProvider:  sp,
+			authorProvider: ap,
+			Logger:         log,
+			Metrics:        metrics,
+		}
+		schema.types = schema.buildTypes()
+	}
+	return schema
+}
+
+// GetSchema returns the singleton instance of Schema
+func GetSchema() *Schema {
+	return schema
+}
+
+var listArguments = map[string]argumentDef{
+	"limit":  {typeName: typeInt, defaultValue: int64(defaultListLimit)},
+	"offset": {typeName: typeInt, defaultValue: int64(0)},
+}
+
+func (s *Schema) buildTypes() map[string]*objectType {
+	query := &objectType{name: "Query", fields: map[string]*fieldDef{
+		"story": {
+			typeName:  "Story",
+			arguments: map[string]argumentDef{"id": {typeName: typeID, nonNull: true}},
+			resolve: func(r *request, _ interface{}, args map[string]interface{}) (interface{}, error) {
+				id := args["id"].(string)
+				story, err := s.storyProvider.GetStory(r.ctx, id)
+				if err != nil {
+					return nil, err
+				}
+				visible, err := r.visibility.CanView(r.ctx, story)
+				if err != nil {
+					return nil, err
+				}
+				// Drafts of other users' authors are reported as missing
+				if !visible {
+					return nil, errors.NewNotFoundError("story", id)
+				}
+				return story, nil
+			},
+		},
+		"stories": {
+			typeName:  "Story",
+			list:      true,
+			arguments: listArguments,
+			resolve: func(r *request, _ interface{}, args map[string]interface{}) (interface{}, error) {
+				limit, offset := pagination(args)
+				return s.storyProvider.ListStories(r.ctx, limit, offset)
+			},
+		},
+		"author": {
+			typeName:  "Author",
+			arguments: map[string]argumentDef{"id": {typeName: typeID, nonNull: true}},
+			resolve: func(r *request, _ interface{}, args map[string]interface{}) (interface{}, error) {
+				id, err := strconv.ParseUint(args["id"].(string), 10, 64)
+				if err != nil {
+					return nil, errors.NewValidationError("invalid author id")
+				}
+				return r.authors.load(uint(id))
+			},
+		},
+		"authorBySlug": {
+			typeName:  "Author",
+			arguments: map[string]argumentDef{"slug": {typeName: typeString, nonNull: true}},
+			resolve: func(r *request, _ interface{}, args map[string]interface{}) (interface{}, error) {
+				author, err := s.authorProvider.GetAuthorBySlug(r.ctx, args["slug"].(string))
+				if err != nil {
+					return nil, err
+				}
+				r.authors.prime(author)
+				return author, nil
+			},
+		},
+	}}
+
+	story := &objectType{name: "Story", fields: map[string]*fieldDef{
+		"id":          storyScalar(typeID, func(st *storydomain.Story) interface{} { return strconv.FormatUint(uint64(st.ID), 10) }),
+		"title":       storyScalar(typeString, func(st *storydomain.Story) interface{} { return st.Title }),
+		"content":     storyScalar(typeString, func(st *storydomain.Story) interface{} { return st.Content }),
+		"publishedAt": storyScalar(typeDateTime, func(st *storydomain.Story) interface{} { return timeValue(st.PublishedAt) }),
+		"createdAt":   storyScalar(typeDateTime, func(st *storydomain.Story) interface{} { return timeValue(&st.CreatedAt) }),
+		"views":       storyScalar(typeInt, func(st *storydomain.Story) interface{} { return st.Views }),
+		"likes":       storyScalar(typeInt, func(st *storydomain.Story) interface{} { return st.Likes }),
+		"comments":    storyScalar(typeInt, func(st *storydomain.Story) interface{} { return st.Comments }),
+		"author": {
+			typeName: "Author",
+			resolve: func(r *request, source interface{}, _ map[string]interface{}) (interface{}, error) {
+				return r.authors.load(source.(*storydomain.Story).AuthorID)
+			},
+		},
+	}}
+	// Authors of a story list are fetched with a single lookup
+	story.prefetch = func(r *request, sources []interface{}, selections []*field) {
+		if !selects(selections, "author") {
+			return
+		}
+		ids := make([]uint, 0, len(sources))
+		for _, source := range sources {
+			ids = append(ids, source.(*storydomain.Story).AuthorID)
+		}
+		r.authors.loadMany(ids)
+	}
+
+	author := &objectType{name: "Author", fields: map[string]*fieldDef{
+		"id":              authorScalar(typeID, func(a *authordomain.Author) interface{} { return strconv.FormatUint(uint64(a.ID), 10) }),
+		"name":            authorScalar(typeString, func(a *authordomain.Author) interface{} { return a.FirstName + " " + a.LastName }),
+		"firstName":       authorScalar(typeString, func(a *authordomain.Author) interface{} { return a.FirstName }),
+		"lastName":        authorScalar(typeString, func(a *authordomain.Author) interface{} { return a.LastName }),
+		"slug":            authorScalar(typeString, func(a *authordomain.Author) interface{} { return a.Slug }),
+		"profileImageUrl": authorScalar(typeString, func(a *authordomain.Author) interface{} { return a.ProfileImageURL }),
+		"profilePageUrl":  authorScalar(typeString, func(a *authordomain.Author) interface{} { return builder.ProfilePageURL(a) }),
+		"bio":             authorScalar(typeString, func(a *authordomain.Author) interface{} { return a.Bio }),
+		"location":        authorScalar(typeString, func(a *authordomain.Author) interface{} { return a.Location }),
+		"verified":        authorScalar(typeBoolean, func(a *authordomain.Author) interface{} { return a.Verified }),
+		"createdAt":       authorScalar(typeDateTime, func(a *authordomain.Author) interface{} { return timeValue(&a.CreatedAt) }),
+		"stories": {
+			typeName:  "Story",
+			list:      true,
+			arguments: listArguments,
+			resolve: func(r *request, source interface{}, args map[string]interface{}) (interface{}, error) {
+				author := source.(*authordomain.Author)
+				limit, offset := pagination(args)
+				// Owners of the author see its drafts too
+				owner, err := r.visibility.OwnsAuthor(r.ctx, author.ID)
+				if err != nil {
+					return nil, err
+				}
+				stories, err := s.storyProvider.ListStoriesByAuthor(r.ctx, strconv.FormatUint(uint64(author.ID), 10), owner, limit, offset)
+				if err != nil {
+					return nil, err
+				}
+				// The author of these stories is already known
+				r.authors.prime(author)
+				return stories, nil
+			},
+		},
+	}}
+
+	return map[string]*objectType{
+		query.name:  query,
+		story.name:  story,
+		author.name: author,
+	}
+}
+
+func storyScalar(typeName string, value func(*storydomain.Story) interface{}) *fieldDef {
+	return &fieldDef{
+		typeName: typeName,
+		resolve: func(_ *request, source interface{}, _ map[string]interface{}) (interface{}, error) {
+			return value(source.(*storydomain.Story)), nil
+		},
+	}
+}
+
+func authorScalar(typeName string, value func(*authordomain.Author) interface{}) *fieldDef {
+	return &fieldDef{
+		typeName: typeName,
+		resolve: func(_ *request, source interface{}, _ map[string]interface{}) (interface{}, error) {
+			return value(source.(*authordomain.Author)), nil
+		},
+	}
+}
+
+// timeValue renders a timestamp as RFC 3339, or null when unset
+func timeValue(t *time.Time) interface{} {
+	if t == nil {
+		return nil
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+// pagination returns the coerced limit and offset arguments of a list field
+func pagination(args map[string]interface{}) (limit, offset int) {
+	return int(args["limit"].(int64)), int(args["offset"].(int64))
+}
+
+// selects reports whether a selection set includes the named field
+func selects(selections []*field, name string) bool {
+	for _, f := range selections {
+		if f.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// isScalar reports whether typeName is a leaf type
+func isScalar(typeName string) bool {
+	switch typeName {
+	case typeID, typeString, typeInt, typeBoolean, typeDateTime:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,350 @@
+package graphql
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+)
+
+// validator checks an operation against the schema before anything is resolved.
+// It coerces arguments and reports every problem it finds rather than the first.
+type validator struct {
+	schema    *Schema
+	defined   map[string]bool
+	variables map[string]interface{}
+	fragments map[string]*fragmentDefinition
+	// selected counts the fields selected once fragments are expanded
+	selected int
+	errors   []*Error
+}
+
+// validate checks op and coerces its variables and arguments. It returns the
+// errors to report; the operation must not be executed when there are any.
+func (s *Schema) validate(op *operation, variables map[string]interface{}) []*Error {
+	v := &validator{schema: s, defined: map[string]bool{}, fragments: op.fragments}
+	v.variables = v.coerceVariables(op.variables, variables)
+	if len(v.errors) > 0 {
+		return v.errors
+	}
+
+	v.checkFragments()
+	if len(v.errors) > 0 {
+		return v.errors
+	}
+
+	op.selections = v.validateSelections(s.types["Query"], op.selections)
+	if len(v.errors) > 0 {
+		return v.errors
+	}
+
+	// Depth is checked first, it also bounds the complexity computation
+	if depth := selectionDepth(op.selections); depth > MaxDepth {
+		v.errorf(nil, "query depth %d exceeds the maximum of %d", depth, MaxDepth)
+		return v.errors
+	}
+	if complexity := v.complexity(s.types["Query"], op.selections); complexity > MaxComplexity {
+		v.errorf(nil, "query complexity %d exceeds the maximum of %d", complexity, MaxComplexity)
+	}
+	return v.errors
+}
+
+func (v *validator) errorf(f *field, format string, args ...interface{}) {
+	err := &Error{Message: fmt.Sprintf(format, args...)}
+	if f != nil {
+		err.Locations = []Location{{Line: f.line, Column: f.column}}
+	}
+	v.errors = append(v.errors, err)
+}
+
+// checkFragments reports fragments on unknown types and fragments that spread
+// themselves, directly or through other fragments, which could never be expanded
+func (v *validator) checkFragments() {
+	names := make([]string, 0, len(v.fragments))
+	for name := range v.fragments {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		fragment := v.fragments[name]
+		at := &field{line: fragment.line, column: fragment.column}
+		if _, ok := v.schema.types[fragment.typeCondition]; !ok {
+			v.errorf(at, "fragment %q has unknown type %s", name, fragment.typeCondition)
+		}
+		if v.reaches(name, name, map[string]bool{}) {
+			v.errorf(at, "fragment %q spreads itself", name)
+		}
+	}
+}
+
+// reaches reports whether the fragment from spreads target, directly or through
+// the fragments it spreads
+func (v *validator) reaches(from, target string, visited map[string]bool) bool {
+	for _, spread := range fragmentSpreads(v.fragments[from].selections) {
+		if spread == target {
+			return true
+		}
+		if visited[spread] || v.fragments[spread] == nil {
+			continue
+		}
+		visited[spread] = true
+		if v.reaches(spread, target, visited) {
+			return true
+		}
+	}
+	return false
+}
+
+// fragmentSpreads returns the names of the fragments spread anywhere in selections
+func fragmentSpreads(selections []*field) []string {
+	var names []string
+	for _, f := range selections {
+		if f.fragment != nil && f.fragment.name != "" {
+			names = append(names, f.fragment.name)
+		}
+		names = append(names, fragmentSpreads(f.selections)...)
+	}
+	return names
+}
+
+// expandFragments replaces the fragments in selections on parent by the fields they
+// select. Fields are copied, so a fragment spread in several places is never shared.
+func (v *validator) expandFragments(parent *objectType, selections []*field) []*field {
+	expanded := make([]*field, 0, len(selections))
+	for _, f := range selections {
+		if v.selected > MaxSelections {
+			return expanded
+		}
+		if f.fragment == nil {
+			v.selected++
+			if v.selected > MaxSelections {
+				v.errorf(f, "query selects more than %d fields", MaxSelections)
+				return expanded
+			}
+			selected := *f
+			selected.selections = append([]*field(nil), f.selections...)
+			expanded = append(expanded, &selected)
+			continue
+		}
+
+		typeCondition, fragmentSelections := f.fragment.typeCondition, f.selections
+		if f.fragment.name != "" {
+			fragment, ok := v.fragments[f.fragment.name]
+			if !ok {
+				v.errorf(f, "unknown fragment %q", f.fragment.name)
+				continue
+			}
+			typeCondition, fragmentSelections = fragment.typeCondition, fragment.selections
+		}
+		if typeCondition != "" && typeCondition != parent.name {
+			if _, ok := v.schema.types[typeCondition]; !ok {
+				v.errorf(f, "unknown type %s", typeCondition)
+			} else {
+				v.errorf(f, "fragment on %s cannot be spread on type %s", typeCondition, parent.name)
+			}
+			continue
+		}
+		expanded = append(expanded, v.expandFragments(parent, fragmentSelections)...)
+	}
+	return expanded
+}
+
+func (v *validator) coerceVariables(definitions []*variableDefinition, values map[string]interface{}) map[string]interface{} {
+	coerced := map[string]interface{}{}
+	for _, definition := range definitions {
+		v.defined[definition.name] = true
+		if !isScalar(definition.typeName) {
+			v.errorf(nil, "variable $%s has unknown type %s", definition.name, definition.typeName)
+			continue
+		}
+		value, given := values[definition.name]
+		if !given || value == nil {
+			value = definition.defaultValue
+		}
+		if value == nil {
+			if definition.nonNull {
+				v.errorf(nil, "variable $%s of type %s! is required", definition.name, definition.typeName)
+			}
+			continue
+		}
+		result, err := coerceScalar(value, definition.typeName)
+		if err != nil {
+			v.errorf(nil, "variable $%s: %s", definition.name, err.Error())
+			continue
+		}
+		coerced[definition.name] = result
+	}
+	return coerced
+}
+
+// validateSelections checks selections on parent and returns them with their
+// fragments expanded. A field selected more than once, such as directly and
+// through a fragment, is merged when its arguments are the same.
+func (v *validator) validateSelections(parent *objectType, selections []*field) []*field {
+	var merged []*field
+	byKey := map[string]*field{}
+	for _, f := range v.expandFragments(parent, selections) {
+		key := f.responseKey()
+		previous, ok := byKey[key]
+		if !ok {
+			byKey[key] = f
+			merged = append(merged, f)
+			continue
+		}
+		if previous.name != f.name || !sameArguments(previous.arguments, f.arguments) {
+			v.errorf(f, "field %q is selected twice with different fields or arguments; use an alias", key)
+			continue
+		}
+		previous.selections = append(previous.selections, f.selections...)
+	}
+
+	for _, f := range merged {
+		def, ok := parent.fields[f.name]
+		if !ok {
+			v.errorf(f, "cannot query field %q on type %s", f.name, parent.name)
+			continue
+		}
+		v.coerceArguments(f, def)
+
+		if isScalar(def.typeName) {
+			if len(f.selections) > 0 {
+				v.errorf(f, "field %q of type %s cannot have a selection", f.name, def.typeName)
+			}
+			continue
+		}
+		if len(f.selections) == 0 {
+			v.errorf(f, "field %q of type %s must have a selection", f.name, def.typeName)
+			continue
+		}
+		f.selections = v.validateSelections(v.schema.types[def.typeName], f.selections)
+	}
+	return merged
+}
+
+// sameArguments reports whether two selections of a field pass the same arguments
+func sameArguments(a, b map[string]interface{}) bool {
+	if len(a) == 0 && len(b) == 0 {
+		return true
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+func (v *validator) coerceArguments(f *field, def *fieldDef) {
+	f.values = map[string]interface{}{}
+	for name := range f.arguments {
+		if _, ok := def.arguments[name]; !ok {
+			v.errorf(f, "unknown argument %q on field %q", name, f.name)
+		}
+	}
+
+	// Arguments are checked in a stable order so errors are reported consistently
+	names := make([]string, 0, len(def.arguments))
+	for name := range def.arguments {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		argument := def.arguments[name]
+		value := f.arguments[name]
+		if ref, ok := value.(variableRef); ok {
+			if !v.defined[ref.name] {
+				v.errorf(f, "variable $%s is not defined", ref.name)
+				continue
+			}
+			value = v.variables[ref.name]
+		}
+		if value == nil {
+			value = argument.defaultValue
+		}
+		if value == nil {
+			if argument.nonNull {
+				v.errorf(f, "argument %q of type %s! is required on field %q", name, argument.typeName, f.name)
+			}
+			continue
+		}
+		result, err := coerceScalar(value, argument.typeName)
+		if err != nil {
+			v.errorf(f, "argument %q on field %q: %s", name, f.name, err.Error())
+			continue
+		}
+		f.values[name] = result
+	}
+
+	if def.list {
+		if limit, ok := f.values["limit"].(int64); ok && (limit < 1 || limit > maxListLimit) {
+			v.errorf(f, "limit on field %q must be between 1 and %d", f.name, maxListLimit)
+		}
+		if offset, ok := f.values["offset"].(int64); ok && offset < 0 {
+			v.errorf(f, "offset on field %q cannot be negative", f.name)
+		}
+	}
+}
+
+// complexity counts the fields a selection resolves. The children of a list field
+// are counted once per item it may return.
+func (v *validator) complexity(parent *objectType, selections []*field) int64 {
+	var total int64
+	for _, f := range selections {
+		def := parent.fields[f.name]
+		cost := int64(1)
+		if !isScalar(def.typeName) {
+			children := v.complexity(v.schema.types[def.typeName], f.selections)
+			if limit, ok := f.values["limit"].(int64); ok && def.list {
+				children *= limit
+			}
+			cost += children
+		}
+		total += cost
+	}
+	return total
+}
+
+// selectionDepth returns how deeply selections nest, counting the root fields as 1
+func selectionDepth(selections []*field) int {
+	depth := 0
+	for _, f := range selections {
+		if d := 1 + selectionDepth(f.selections); d > depth {
+			depth = d
+		}
+	}
+	return depth
+}
+
+// coerceScalar converts a literal or variable value to the representation resolvers
+// expect: string for ID and String, int64 for Int and bool for Boolean
+func coerceScalar(value interface{}, typeName string) (interface{}, error) {
+	switch typeName {
+	case typeID:
+		switch value := value.(type) {
+		case string:
+			return value, nil
+		case int64:
+			return strconv.FormatInt(value, 10), nil
+		case json.Number:
+			if _, err := value.Int64(); err == nil {
+				return value.String(), nil
+			}
+		}
+	case typeString:
+		if value, ok := value.(string); ok {
+			return value, nil
+		}
+	case typeInt:
+		switch value := value.(type) {
+		case int64:
+			return value, nil
+		case json.Number:
+			if n, err := value.Int64(); err == nil {
+				return n, nil
+			}
+		}
+	case typeBoolean:
+		if value, ok := value.(bool); ok {
+			return value, nil
+		}
+	}
+	return nil, fmt.Errorf("expected a value of type %s", typeName)
+}
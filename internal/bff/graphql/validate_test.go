@@ -0,0 +1,194 @@
+package graphql
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"go-monolith/pkg/logger"
+	"go-monolith/pkg/metrics"
+)
+
+func newTestSchema(stories *fakeStories, authors *fakeAuthors) *Schema {
+	s := &Schema{
+		storyProvider:  stories,
+		authorProvider: authors,
+		Logger:         logger.Default(),
+		Metrics:        &metrics.Client{},
+	}
+	s.types = s.buildTypes()
+	return s
+}
+
+// validateQuery parses and validates query, failing the test on syntax errors
+func validateQuery(t *testing.T, query string, variables map[string]interface{}) (*operation, []*Error) {
+	t.Helper()
+	op, err := parse(query, "")
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	return op, newTestSchema(nil, nil).validate(op, variables)
+}
+
+func TestValidateAcceptsQueries(t *testing.T) {
+	queries := []string{
+		`{ story(id: 1) { id title author { name } } }`,
+		`{ stories(limit: 20, offset: 40) { id } authorBySlug(slug: "jane-doe") { slug } }`,
+		`query($id: ID!) { author(id: $id) { stories { title } } }`,
+		`{ story(id: 1) { id id } }`,
+		`{ story(id: 1) { ...StoryFields } } fragment StoryFields on Story { id author { ...AuthorFields } } fragment AuthorFields on Author { name }`,
+		`{ story(id: 1) { ... on Story { id } ... { title } } }`,
+	}
+	for _, query := range queries {
+		if _, errs := validateQuery(t, query, map[string]interface{}{"id": "1"}); len(errs) > 0 {
+			t.Errorf("%s: unexpected errors %v", query, messages(errs))
+		}
+	}
+}
+
+func TestValidateRejectsQueries(t *testing.T) {
+	tests := []struct {
+		name      string
+		query     string
+		variables map[string]interface{}
+		want      string
+	}{
+		{"unknown field", `{ story(id: 1) { isbn } }`, nil, `cannot query field "isbn" on type Story`},
+		{"unknown root field", `{ books { id } }`, nil, `cannot query field "books" on type Query`},
+		{"missing argument", `{ story { id } }`, nil, `argument "id" of type ID! is required on field "story"`},
+		{"unknown argument", `{ story(id: 1, isbn: 2) { id } }`, nil, `unknown argument "isbn" on field "story"`},
+		{"wrong argument type", `{ stories(limit: "ten") { id } }`, nil, `argument "limit" on field "stories": expected a value of type Int`},
+		{"undefined variable", `{ story(id: $id) { id } }`, nil, "variable $id is not defined"},
+		{"missing variable", `query($id: ID!) { story(id: $id) { id } }`, nil, "variable $id of type ID! is required"},
+		{"wrong variable type", `query($limit: Int) { stories(limit: $limit) { id } }`, map[string]interface{}{"limit": "ten"}, "variable $limit: expected a value of type Int"},
+		{"unknown variable type", `query($story: StoryInput) { stories { id } }`, nil, "variable $story has unknown type StoryInput"},
+		{"selection on scalar", `{ story(id: 1) { title { length } } }`, nil, `field "title" of type String cannot have a selection`},
+		{"object without selection", `{ story(id: 1) }`, nil, `field "story" of type Story must have a selection`},
+		{"limit too large", `{ stories(limit: 51) { id } }`, nil, `limit on field "stories" must be between 1 and 50`},
+		{"negative offset", `{ stories(offset: -1) { id } }`, nil, `offset on field "stories" cannot be negative`},
+		{"conflicting alias", `{ story(id: 1) { id: title id } }`, nil, `field "id" is selected twice with different fields or arguments`},
+		{"conflicting arguments", `{ story(id: 1) { id } story(id: 2) { id } }`, nil, `field "story" is selected twice with different fields or arguments`},
+		{"unknown fragment", `{ story(id: 1) { ...StoryFields } }`, nil, `unknown fragment "StoryFields"`},
+		{"fragment on wrong type", `{ story(id: 1) { ...AuthorFields } } fragment AuthorFields on Author { name }`, nil, "fragment on Author cannot be spread on type Story"},
+		{"inline fragment on wrong type", `{ story(id: 1) { ... on Author { name } } }`, nil, "fragment on Author cannot be spread on type Story"},
+		{"inline fragment on unknown type", `{ story(id: 1) { ... on Book { id } } }`, nil, "unknown type Book"},
+		{"fragment on unknown type", `{ story(id: 1) { id } } fragment BookFields on Book { id }`, nil, `fragment "BookFields" has unknown type Book`},
+		{"fragment spreading itself", `{ story(id: 1) { ...A } } fragment A on Story { author { ...B } } fragment B on Author { stories { ...A } }`, nil, `fragment "A" spreads itself`},
+		{"field error in fragment", `{ story(id: 1) { ...StoryFields } } fragment StoryFields on Story { isbn }`, nil, `cannot query field "isbn" on type Story`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, errs := validateQuery(t, tt.query, tt.variables)
+			if !containsMessage(errs, tt.want) {
+				t.Errorf("errors = %v, want one containing %q", messages(errs), tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateReportsEveryError(t *testing.T) {
+	_, errs := validateQuery(t, `{ story(id: 1) { isbn } author { name } }`, nil)
+	if len(errs) != 2 {
+		t.Fatalf("errors = %v, want 2", messages(errs))
+	}
+	if errs[0].Locations[0] != (Location{Line: 1, Column: 18}) {
+		t.Errorf("location = %+v, want 1:18", errs[0].Locations[0])
+	}
+}
+
+func TestValidateCoercesArguments(t *testing.T) {
+	op, errs := validateQuery(t, `query($limit: Int) { stories(limit: $limit) { id } story(id: 7) { id } }`,
+		map[string]interface{}{"limit": json.Number("3")})
+	if len(errs) > 0 {
+		t.Fatalf("unexpected errors %v", messages(errs))
+	}
+	if got := op.selections[0].values; !reflect.DeepEqual(got, map[string]interface{}{"limit": int64(3), "offset": int64(0)}) {
+		t.Errorf("stories values = %v", got)
+	}
+	if got := op.selections[1].values; !reflect.DeepEqual(got, map[string]interface{}{"id": "7"}) {
+		t.Errorf("story values = %v", got)
+	}
+}
+
+func TestValidateExpandsAndMergesFragments(t *testing.T) {
+	op, errs := validateQuery(t, `
+		{ story(id: 1) { id ...StoryFields ... on Story { author { slug } } } }
+		fragment StoryFields on Story { id title author { name } }`, nil)
+	if len(errs) > 0 {
+		t.Fatalf("unexpected errors %v", messages(errs))
+	}
+
+	story := op.selections[0]
+	if got := fieldNames(story.selections); !reflect.DeepEqual(got, []string{"id", "title", "author"}) {
+		t.Fatalf("story selections = %v, want [id title author]", got)
+	}
+	if got := fieldNames(story.selections[2].selections); !reflect.DeepEqual(got, []string{"name", "slug"}) {
+		t.Errorf("author selections = %v, want [name slug]", got)
+	}
+	if fragment := fieldNames(op.fragments["StoryFields"].selections); !reflect.DeepEqual(fragment, []string{"id", "title", "author"}) {
+		t.Errorf("fragment definition changed to %v", fragment)
+	}
+}
+
+func TestValidateDepthLimit(t *testing.T) {
+	// Depth 5 is allowed, one more level is not
+	_, errs := validateQuery(t, `{ story(id: 1) { author { stories { author { id } } } } }`, nil)
+	if len(errs) > 0 {
+		t.Fatalf("depth 5: unexpected errors %v", messages(errs))
+	}
+	_, errs = validateQuery(t, `{ story(id: 1) { author { stories { author { stories { id } } } } } }`, nil)
+	if !containsMessage(errs, "query depth 6 exceeds the maximum of 5") {
+		t.Errorf("depth 6: errors = %v", messages(errs))
+	}
+
+	// Fragments count towards the depth of the fields they are spread into
+	_, errs = validateQuery(t, `{ story(id: 1) { author { ...Deep } } } fragment Deep on Author { stories { author { stories { id } } } }`, nil)
+	if !containsMessage(errs, "query depth 6 exceeds the maximum of 5") {
+		t.Errorf("depth 6 through a fragment: errors = %v", messages(errs))
+	}
+}
+
+func TestValidateComplexityLimit(t *testing.T) {
+	// 1 + 10 * (1 + 1 + 2) = 41
+	_, errs := validateQuery(t, `{ stories { id author { name slug } } }`, nil)
+	if len(errs) > 0 {
+		t.Fatalf("unexpected errors %v", messages(errs))
+	}
+
+	// 1 + 50 * (1 + 1 + 50 * 1) = 2601
+	_, errs = validateQuery(t, `{ stories(limit: 50) { author { stories(limit: 50) { id } } } }`, nil)
+	if !containsMessage(errs, "query complexity 2601 exceeds the maximum of 500") {
+		t.Errorf("errors = %v", messages(errs))
+	}
+}
+
+func TestValidateSelectionLimit(t *testing.T) {
+	// Each fragment spreads the previous one twice, doubling the fields selected
+	var query strings.Builder
+	query.WriteString(`{ story(id: 1) { ...F8 } } fragment F1 on Story { id title content views likes comments publishedAt createdAt }`)
+	for i := 2; i <= 8; i++ {
+		query.WriteString(" fragment F" + string(rune('0'+i)) + " on Story { ...F" + string(rune('0'+i-1)) + " ...F" + string(rune('0'+i-1)) + " }")
+	}
+	_, errs := validateQuery(t, query.String(), nil)
+	if !containsMessage(errs, "query selects more than 1000 fields") {
+		t.Errorf("errors = %v", messages(errs))
+	}
+}
+
+func messages(errs []*Error) []string {
+	result := make([]string, len(errs))
+	for i, err := range errs {
+		result[i] = err.Message
+	}
+	return result
+}
+
+func containsMessage(errs []*Error, want string) bool {
+	for _, err := range errs {
+		if strings.Contains(err.Message, want) {
+			return true
+		}
+	}
+	return false
+}
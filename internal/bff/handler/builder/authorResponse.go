@@ -0,0 +1,112 @@
+package builder
+
+import (
+	"strings"
+
+	authorDomain "go-monolith/internal/modules/author/domain"
+)
+
+// profilePageBaseURL is where author profile pages live, see SetProfilePageBaseURL
+var profilePageBaseURL = "/authors"
+
+// SetProfilePageBaseURL configures the base of the computed author profile page URLs
+func SetProfilePageBaseURL(baseURL string) {
+	profilePageBaseURL = strings.TrimRight(baseURL, "/")
+}
+
+// ProfilePageURL returns the public profile page of the author
+func ProfilePageURL(author *authorDomain.Author) string {
+	return profilePageBaseURL + "/" + author.Slug
+}
+
+// BuildAuthorResponse projects an author onto the fields requested in structure
+func BuildAuthorResponse(author *authorDomain.Author, structure ResponseStructure) AuthorResponse {
+	resp := AuthorResponse{}
+
+	if _, ok := structure["id"]; ok {
+		resp.ID = &author.ID
+	}
+	if _, ok := structure["name"]; ok {
+		fullName := author.FirstName + " " + author.LastName
+		resp.Name = &fullName
+	}
+	if _, ok := structure["slug"]; ok {
+		resp.Slug = &author.Slug
+	}
+	if _, ok := structure["profileImageUrl"]; ok {
+		resp.ProfileImageURL = &author.ProfileImageURL
+	}
+	if _, ok := structure["profilePageUrl"]; ok {
+		pageURL := ProfilePageURL(author)
+		resp.ProfilePageURL = &pageURL
+	}
+	if _, ok := structure["bio"]; ok {
+		resp.Bio = &author.Bio
+	}
+	if _, ok := structure["location"]; ok {
+		resp.Location = &author.Location
+	}
+	if _, ok := structure["links"]; ok {
+		resp.Links = make([]LinkResponse, len(author.Links))
+		for i, link := range author.Links {
+			resp.Links[i] = LinkResponse{Kind: string(link.Kind), URL: link.URL}
+		}
+	}
+	if _, ok := structure["verified"]; ok {
+		resp.Verified = &author.Verified
+	}
+	// Verifier details are only meaningful for verified authors
+	if _, ok := structure["verifiedBy"]; ok && author.Verified {
+		resp.VerifiedBy = &author.VerifiedBy
+	}
+	if _, ok := structure["verifiedAt"]; ok && author.Verified {
+		resp.VerifiedAt = author.VerifiedAt
+	}
+	if _, ok := structure["storyCount"]; ok {
+		resp.StoryCount = &author.StoryCount
+	}
+	if _, ok := structure["createdAt"]; ok {
+		resp.CreatedAt = &author.CreatedAt
+	}
+	// Aggregates are only present when the caller loaded them
+	if statsStructure, ok := structure["stats"].(map[string]interface{}); ok && author.Stats != nil {
+		resp.Stats = buildAuthorAggregatesResponse(author.Stats, statsStructure)
+	}
+
+	return resp
+}
+
+func buildAuthorAggregatesResponse(stats *authorDomain.Stats, structure ResponseStructure) *AuthorAggregatesResponse {
+	resp := &AuthorAggregatesResponse{}
+
+	if _, ok := structure["publishedStories"]; ok {
+		resp.PublishedStories = &stats.PublishedStories
+	}
+	if _, ok := structure["totalViews"]; ok {
+		resp.TotalViews = &stats.TotalViews
+	}
+	if _, ok := structure["totalLikes"]; ok {
+		resp.TotalLikes = &stats.TotalLikes
+	}
+	if _, ok := structure["firstPublishedAt"]; ok {
+		resp.FirstPublishedAt = stats.FirstPublishedAt
+	}
+	if _, ok := structure["latestPublishedAt"]; ok {
+		resp.LatestPublishedAt = stats.LastPublishedAt
+	}
+
+	return resp
+}
+
+// BuildMergeResponse describes an applied or previewed author merge
+func BuildMergeResponse(plan *authorDomain.MergePlan) MergeResponse {
+	return MergeResponse{
+		SourceID:      plan.SourceID,
+		TargetID:      plan.TargetID,
+		SourceSlug:    plan.SourceSlug,
+		Stories:       plan.Stories,
+		Followers:     plan.Followers,
+		RedirectSlugs: plan.RedirectSlugs,
+		DryRun:        plan.DryRun,
+	}
+}
@@ -0,0 +1,181 @@
+package builder
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"go-monolith/pkg/errors"
+)
+
+// maxFieldsLength bounds the fields query parameter
+const maxFieldsLength = 1024
+
+// FieldsError is returned when a fields query is malformed or asks for fields the
+// API version does not expose. ValidFields lists what may be requested instead.
+type FieldsError struct {
+	errors.BaseError
+	ValidFields []string
+}
+
+// ProblemExtensions adds the valid fields to the problem response describing the error
+func (e *FieldsError) ProblemExtensions() map[string]interface{} {
+	return map[string]interface{}{"validFields": e.ValidFields}
+}
+
+func newFieldsError(message string, allowed ResponseStructure) error {
+	return &FieldsError{
+		BaseError: errors.BaseError{
+			Kind:    errors.ErrKindValidation,
+			Message: message,
+		},
+		ValidFields: FormatFields(allowed),
+	}
+}
+
+// ParseFields parses a client field selection such as
+// "id,title,author(name,profileImageUrl)" into a ResponseStructure. Every field must
+// appear in allowed; a nested field without a selection gets all its allowed
+// sub-fields. An empty query returns defaults.
+func ParseFields(query string, allowed, defaults ResponseStructure) (ResponseStructure, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return defaults, nil
+	}
+	if len(query) > maxFieldsLength {
+		return nil, newFieldsError("fields parameter is too long", allowed)
+	}
+
+	p := &fieldsParser{input: query, allowed: allowed}
+	structure, err := p.parseList(allowed, "")
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.input) {
+		return nil, p.syntaxError()
+	}
+	if len(p.unknown) > 0 {
+		return nil, newFieldsError("unknown fields: "+strings.Join(p.unknown, ", "), allowed)
+	}
+	return structure, nil
+}
+
+// FormatFields renders a structure in the fields query syntax, one entry per
+// top-level field in alphabetical order
+func FormatFields(structure ResponseStructure) []string {
+	fields := make([]string, 0, len(structure))
+	for name, value := range structure {
+		if nested, ok := asStructure(value); ok {
+			fields = append(fields, name+"("+strings.Join(FormatFields(nested), ",")+")")
+			continue
+		}
+		fields = append(fields, name)
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+// fieldsParser is a recursive descent parser over the fields grammar:
+//
+//	list  = field { "," field }
+//	field = name [ "(" list ")" ]
+type fieldsParser struct {
+	input   string
+	pos     int
+	allowed ResponseStructure
+	unknown []string
+}
+
+func (p *fieldsParser) parseList(allowed ResponseStructure, prefix string) (ResponseStructure, error) {
+	structure := ResponseStructure{}
+	for {
+		name := p.parseName()
+		if name == "" {
+			return nil, p.syntaxError()
+		}
+
+		value, known := allowed[name]
+		nested, isNested := asStructure(value)
+		if !known {
+			p.unknown = append(p.unknown, prefix+name)
+		}
+
+		if p.consume('(') {
+			var sub ResponseStructure
+			var err error
+			if known && isNested {
+				sub, err = p.parseList(nested, prefix+name+".")
+			} else {
+				// Keep parsing so every unknown field is reported at once
+				reported := len(p.unknown)
+				sub, err = p.parseList(ResponseStructure{}, prefix+name+".")
+				if known {
+					// A plain field cannot take a selection; report it once
+					p.unknown = append(p.unknown[:reported], prefix+name+"(...)")
+				}
+			}
+			if err != nil {
+				return nil, err
+			}
+			if !p.consume(')') {
+				return nil, p.syntaxError()
+			}
+			if known && isNested {
+				structure[name] = map[string]interface{}(sub)
+			}
+		} else if known && isNested {
+			structure[name] = map[string]interface{}(nested)
+		} else if known {
+			structure[name] = value
+		}
+
+		if !p.consume(',') {
+			return structure, nil
+		}
+	}
+}
+
+func (p *fieldsParser) parseName() string {
+	p.skipSpaces()
+	start := p.pos
+	for p.pos < len(p.input) && isFieldNameChar(p.input[p.pos]) {
+		p.pos++
+	}
+	name := p.input[start:p.pos]
+	p.skipSpaces()
+	return name
+}
+
+func (p *fieldsParser) consume(c byte) bool {
+	p.skipSpaces()
+	if p.pos < len(p.input) && p.input[p.pos] == c {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *fieldsParser) skipSpaces() {
+	for p.pos < len(p.input) && p.input[p.pos] == ' ' {
+		p.pos++
+	}
+}
+
+func (p *fieldsParser) syntaxError() error {
+	return newFieldsError("invalid fields parameter near position "+strconv.Itoa(p.pos+1), p.allowed)
+}
+
+func isFieldNameChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_'
+}
+
+// asStructure returns the sub-structure of a nested field
+func asStructure(value interface{}) (ResponseStructure, bool) {
+	switch nested := value.(type) {
+	case ResponseStructure:
+		return nested, true
+	case map[string]interface{}:
+		return nested, true
+	}
+	return nil, false
+}
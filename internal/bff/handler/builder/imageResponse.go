@@ -0,0 +1,28 @@
+package builder
+
+import (
+	mediaDomain "go-monolith/internal/modules/media/domain"
+)
+
+// BuildImageResponse projects a media item onto the fields requested in structure.
+// A nil media yields a nil response so the field is omitted.
+func BuildImageResponse(media *mediaDomain.Media, structure map[string]interface{}) *ImageResponse {
+	if media == nil {
+		return nil
+	}
+
+	resp := &ImageResponse{}
+	if _, ok := structure["url"]; ok {
+		resp.URL = &media.URL
+	}
+	if _, ok := structure["width"]; ok {
+		resp.Width = &media.Width
+	}
+	if _, ok := structure["height"]; ok {
+		resp.Height = &media.Height
+	}
+	if _, ok := structure["thumbnails"]; ok {
+		resp.Thumbnails = media.ThumbnailURLs
+	}
+	return resp
+}
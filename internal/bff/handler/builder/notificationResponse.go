@@ -0,0 +1,20 @@
+package builder
+
+import (
+	notificationDomain "go-monolith/internal/modules/notification/domain"
+)
+
+// BuildNotificationResponse builds a notification response with its rendered message
+func BuildNotificationResponse(n *notificationDomain.Notification) NotificationResponse {
+	return NotificationResponse{
+		ID:         n.ID,
+		Type:       string(n.Type),
+		Message:    n.Message(),
+		StoryID:    n.StoryID,
+		AuthorID:   n.AuthorID,
+		ActorCount: n.ActorCount,
+		Read:       n.Read,
+		CreatedAt:  n.CreatedAt,
+		UpdatedAt:  n.UpdatedAt,
+	}
+}
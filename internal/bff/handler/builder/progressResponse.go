@@ -0,0 +1,25 @@
+package builder
+
+import (
+	progressDomain "go-monolith/internal/modules/progress/domain"
+)
+
+// BuildProgressResponse projects reading progress onto the fields requested in structure.
+// A nil progress yields a nil response so the field is omitted.
+func BuildProgressResponse(progress *progressDomain.ReadingProgress, structure map[string]interface{}) *ProgressResponse {
+	if progress == nil {
+		return nil
+	}
+
+	resp := &ProgressResponse{}
+	if _, ok := structure["percent"]; ok {
+		resp.Percent = &progress.Percent
+	}
+	if _, ok := structure["offset"]; ok {
+		resp.Offset = &progress.Offset
+	}
+	if _, ok := structure["lastReadAt"]; ok {
+		resp.LastReadAt = &progress.LastReadAt
+	}
+	return resp
+}
@@ -0,0 +1,26 @@
+package builder
+
+import (
+	readingListDomain "go-monolith/internal/modules/readinglist/domain"
+	storyDomain "go-monolith/internal/modules/story/domain"
+)
+
+// BuildReadingListResponse builds a reading list response. Stories are projected onto
+// storyStructure without author details; pass nil stories to omit them.
+func BuildReadingListResponse(list *readingListDomain.ReadingList, stories []*storyDomain.Story, storyStructure ResponseStructure) ReadingListResponse {
+	resp := ReadingListResponse{
+		ID:         list.ID,
+		Name:       list.Name,
+		IsDefault:  list.IsDefault,
+		Visibility: string(list.Visibility),
+		CreatedAt:  list.CreatedAt,
+		UpdatedAt:  list.UpdatedAt,
+	}
+	if stories != nil {
+		resp.Stories = make([]StoryResponse, len(stories))
+		for i, story := range stories {
+			resp.Stories[i] = BuildStoryResponse(story, nil, storyStructure)
+		}
+	}
+	return resp
+}
@@ -0,0 +1,49 @@
+package builder
+
+import (
+	analyticsDomain "go-monolith/internal/modules/analytics/domain"
+)
+
+type AuthorStatsResponse struct {
+	AuthorID uint                `json:"authorId"`
+	From     string              `json:"from"`
+	To       string              `json:"to"`
+	Totals   EngagementResponse  `json:"totals"`
+	Series   []DailyStatResponse `json:"series"`
+}
+
+type EngagementResponse struct {
+	Views    int64 `json:"views"`
+	Likes    int64 `json:"likes"`
+	Comments int64 `json:"comments"`
+}
+
+type DailyStatResponse struct {
+	Date string `json:"date"`
+	EngagementResponse
+}
+
+func BuildAuthorStatsResponse(stats *analyticsDomain.AuthorStats) AuthorStatsResponse {
+	resp := AuthorStatsResponse{
+		AuthorID: stats.AuthorID,
+		From:     stats.Range.From.Format(analyticsDomain.DateLayout),
+		To:       stats.Range.To.Format(analyticsDomain.DateLayout),
+		Totals:   buildEngagement(stats.Totals),
+		Series:   make([]DailyStatResponse, len(stats.Series)),
+	}
+	for i, day := range stats.Series {
+		resp.Series[i] = DailyStatResponse{
+			Date:               day.Day.Format(analyticsDomain.DateLayout),
+			EngagementResponse: buildEngagement(day),
+		}
+	}
+	return resp
+}
+
+func buildEngagement(stats analyticsDomain.DailyStats) EngagementResponse {
+	return EngagementResponse{
+		Views:    stats.Views,
+		Likes:    stats.Likes,
+		Comments: stats.Comments,
+	}
+}
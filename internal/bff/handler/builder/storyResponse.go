@@ -17,17 +17,14 @@ func BuildStoryResponse(story *storyDomain.Story, author *authorDomain.Author, s
 	if _, ok := structure["content"]; ok {
 		resp.Content = &story.Content
 	}
+	if _, ok := structure["publishedAt"]; ok {
+		resp.PublishedAt = story.PublishedAt
+	}
 
 	// Handle Author
-	if authorStruct, ok := structure["author"].(map[string]interface{}); ok {
-		resp.Author = &AuthorResponse{}
-		if _, include := authorStruct["name"]; include {
-			fullName := author.FirstName + " " + author.LastName // Concatenate the strings directly
-			resp.Author.Name = &fullName                         // Assign the address of the concatenated string
-		}
-		if _, include := authorStruct["profileImageUrl"]; include {
-			resp.Author.ProfileImageURL = &author.ProfileImageURL
-		}
+	if authorStruct, ok := structure["author"].(map[string]interface{}); ok && author != nil {
+		authorResp := BuildAuthorResponse(author, authorStruct)
+		resp.Author = &authorResp
 	}
 
 	// Handle Reviews
@@ -57,3 +54,9 @@ func BuildStoryResponse(story *storyDomain.Story, author *authorDomain.Author, s
 
 	return resp
 }
+
+// AddWarning marks the response as partial because section could not be loaded
+func (r *StoryResponse) AddWarning(section, reason string) {
+	r.Partial = true
+	r.Warnings = append(r.Warnings, WarningResponse{Section: section, Reason: reason})
+}
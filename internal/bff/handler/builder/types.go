@@ -1,5 +1,7 @@
 package builder
 
+import "time"
+
 type StoryResponse struct {
 	ID      *uint            `json:"id,omitempty"`
 	Title   *string          `json:"title,omitempty"`
@@ -9,14 +11,74 @@ type StoryResponse struct {
 	Author  *AuthorResponse  `json:"author,omitempty"`
 	Reviews []ReviewResponse `json:"reviews,omitempty"`
 	Likes   *int             `json:"likes,omitempty"`
+
+	PublishedAt *time.Time `json:"publishedAt,omitempty"`
+
+	CoverImage *ImageResponse    `json:"coverImage,omitempty"`
+	Progress   *ProgressResponse `json:"progress,omitempty"`
+	Saved      *bool             `json:"saved,omitempty"`
+
+	// Partial is set when optional sections listed in Warnings could not be loaded
+	Partial  bool              `json:"partial,omitempty"`
+	Warnings []WarningResponse `json:"warnings,omitempty"`
+}
+
+// WarningResponse names a section left out of a partial response and why
+type WarningResponse struct {
+	Section string `json:"section"`
+	Reason  string `json:"reason"`
+}
+
+// StoryBatchItemResponse is one entry of a multi-story response. Story is set when
+// Status is 200, Error otherwise.
+type StoryBatchItemResponse struct {
+	ID     string         `json:"id"`
+	Status int            `json:"status"`
+	Story  *StoryResponse `json:"story,omitempty"`
+	Error  string         `json:"error,omitempty"`
 }
 
 type AuthorResponse struct {
-	ID              *uint   `json:"id,omitempty"`
-	Name            *string `json:"name,omitempty"`
-	ProfileImageURL *string `json:"profileImageUrl,omitempty"`
-	ProfilePageURL  *string `json:"profilePageUrl,omitempty"`
-	UserID          *int    `json:"userId,omitempty"`
+	ID              *uint      `json:"id,omitempty"`
+	Name            *string    `json:"name,omitempty"`
+	Slug            *string    `json:"slug,omitempty"`
+	ProfileImageURL *string    `json:"profileImageUrl,omitempty"`
+	ProfilePageURL  *string    `json:"profilePageUrl,omitempty"`
+	UserID          *int       `json:"userId,omitempty"`
+	StoryCount      *int64     `json:"storyCount,omitempty"`
+	CreatedAt       *time.Time `json:"createdAt,omitempty"`
+
+	Bio        *string        `json:"bio,omitempty"`
+	Location   *string        `json:"location,omitempty"`
+	Links      []LinkResponse `json:"links,omitempty"`
+	Verified   *bool          `json:"verified,omitempty"`
+	VerifiedBy *string        `json:"verifiedBy,omitempty"`
+	VerifiedAt *time.Time     `json:"verifiedAt,omitempty"`
+
+	Stats *AuthorAggregatesResponse `json:"stats,omitempty"`
+}
+
+type AuthorAggregatesResponse struct {
+	PublishedStories  *int64     `json:"publishedStories,omitempty"`
+	TotalViews        *int64     `json:"totalViews,omitempty"`
+	TotalLikes        *int64     `json:"totalLikes,omitempty"`
+	FirstPublishedAt  *time.Time `json:"firstPublishedAt,omitempty"`
+	LatestPublishedAt *time.Time `json:"latestPublishedAt,omitempty"`
+}
+
+type MergeResponse struct {
+	SourceID      uint     `json:"sourceId"`
+	TargetID      uint     `json:"targetId"`
+	SourceSlug    string   `json:"sourceSlug"`
+	Stories       int64    `json:"stories"`
+	Followers     int64    `json:"followers"`
+	RedirectSlugs []string `json:"redirectSlugs"`
+	DryRun        bool     `json:"dryRun"`
+}
+
+type LinkResponse struct {
+	Kind string `json:"kind"`
+	URL  string `json:"url"`
 }
 
 type ReviewResponse struct {
@@ -25,4 +87,39 @@ type ReviewResponse struct {
 	User   *AuthorResponse `json:"user,omitempty"`
 }
 
+type ImageResponse struct {
+	URL        *string           `json:"url,omitempty"`
+	Width      *int              `json:"width,omitempty"`
+	Height     *int              `json:"height,omitempty"`
+	Thumbnails map[string]string `json:"thumbnails,omitempty"`
+}
+
+type ProgressResponse struct {
+	Percent    *float64   `json:"percent,omitempty"`
+	Offset     *int64     `json:"offset,omitempty"`
+	LastReadAt *time.Time `json:"lastReadAt,omitempty"`
+}
+
+type ReadingListResponse struct {
+	ID         uint            `json:"id"`
+	Name       string          `json:"name"`
+	IsDefault  bool            `json:"isDefault"`
+	Visibility string          `json:"visibility"`
+	CreatedAt  time.Time       `json:"createdAt"`
+	UpdatedAt  time.Time       `json:"updatedAt"`
+	Stories    []StoryResponse `json:"stories,omitempty"`
+}
+
+type NotificationResponse struct {
+	ID         uint      `json:"id"`
+	Type       string    `json:"type"`
+	Message    string    `json:"message"`
+	StoryID    uint      `json:"storyId"`
+	AuthorID   uint      `json:"authorId"`
+	ActorCount int       `json:"actorCount"`
+	Read       bool      `json:"read"`
+	CreatedAt  time.Time `json:"createdAt"`
+	UpdatedAt  time.Time `json:"updatedAt"`
+}
+
 type ResponseStructure map[string]interface{}
@@ -1,21 +1,37 @@
 package handler
 
 import (
-	v1_2 "go-monolith/internal/bff/handler/v1_2"
+	"go-monolith/internal/bff/graphql"
 	v2_0 "go-monolith/internal/bff/handler/v2_0"
 	"go-monolith/internal/bff/service"
 )
 
 // Handlers struct to hold all handlers
 type Handlers struct {
-	V1_2StoryHandler *v1_2.StoryHandler
-	V2_0StoryHandler *v2_0.StoryHandler
+	StoryViewHandler        *StoryViewHandler
+	V2_0StoryHandler        *v2_0.StoryHandler
+	V2_0MediaHandler        *v2_0.MediaHandler
+	V2_0AuthorHandler       *v2_0.AuthorHandler
+	V2_0ProgressHandler     *v2_0.ProgressHandler
+	V2_0ReadingListHandler  *v2_0.ReadingListHandler
+	V2_0FollowHandler       *v2_0.FollowHandler
+	V2_0FeedHandler         *v2_0.FeedHandler
+	V2_0NotificationHandler *v2_0.NotificationHandler
+	GraphQLHandler          *graphql.Handler
 }
 
 // NewHandlers initializes and returns all handlers
-func NewHandlers(storyService *service.StoryService) *Handlers {
+func NewHandlers(storyService *service.StoryService, authorService *service.AuthorService, mediaService *service.MediaService, analyticsService *service.AnalyticsService, progressService *service.ProgressService, readingListService *service.ReadingListService, followService *service.FollowService, feedService *service.FeedService, notificationService *service.NotificationService, schema *graphql.Schema) *Handlers {
 	return &Handlers{
-		V1_2StoryHandler: v1_2.NewStoryHandler(storyService),
-		V2_0StoryHandler: v2_0.NewStoryHandler(storyService),
+		StoryViewHandler:        NewStoryViewHandler(storyService, mediaService, progressService, readingListService),
+		V2_0StoryHandler:        v2_0.NewStoryHandler(storyService),
+		V2_0MediaHandler:        v2_0.NewMediaHandler(mediaService),
+		V2_0AuthorHandler:       v2_0.NewAuthorHandler(authorService, analyticsService),
+		V2_0ProgressHandler:     v2_0.NewProgressHandler(progressService),
+		V2_0ReadingListHandler:  v2_0.NewReadingListHandler(readingListService),
+		V2_0FollowHandler:       v2_0.NewFollowHandler(followService),
+		V2_0FeedHandler:         v2_0.NewFeedHandler(feedService),
+		V2_0NotificationHandler: v2_0.NewNotificationHandler(notificationService),
+		GraphQLHandler:          graphql.NewHandler(schema),
 	}
 }
@@ -0,0 +1,134 @@
+package handler
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"go-monolith/internal/bff/handler/builder"
+	"go-monolith/internal/bff/service"
+	mediadomain "go-monolith/internal/modules/media/domain"
+	progressdomain "go-monolith/internal/modules/progress/domain"
+	appctx "go-monolith/pkg/context"
+	"go-monolith/pkg/errors"
+	"go-monolith/pkg/httpcache"
+	"go-monolith/pkg/problem"
+)
+
+// StoryView declares how an API version reads a single story: where the request
+// carries the story ID, which fields the version exposes and which it returns when
+// the client selects none
+type StoryView struct {
+	// IDParam names the path parameter holding the story ID; IDQuery is used when empty
+	IDParam       string
+	IDQuery       string
+	Fields        builder.ResponseStructure
+	DefaultFields builder.ResponseStructure
+}
+
+// StoryViewHandler serves story reads for every API version, projecting the response
+// onto the fields of the version's StoryView
+type StoryViewHandler struct {
+	storyService       *service.StoryService
+	mediaService       *service.MediaService
+	progressService    *service.ProgressService
+	readingListService *service.ReadingListService
+}
+
+var storyViewHandler *StoryViewHandler
+
+func NewStoryViewHandler(ss *service.StoryService, ms *service.MediaService, ps *service.ProgressService, rs *service.ReadingListService) *StoryViewHandler {
+	if storyViewHandler == nil {
+		storyViewHandler = &StoryViewHandler{
+			storyService:       ss,
+			mediaService:       ms,
+			progressService:    ps,
+			readingListService: rs,
+		}
+	}
+	return storyViewHandler
+}
+
+// GetStory returns the handler reading a story as view declares, such as
+// GET /v1.2/stories?id=123 or GET /v2.0/stories/:id, both with
+// ?fields=id,title,author(name,profileImageUrl)
+func (h *StoryViewHandler) GetStory(view StoryView) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		storyID := c.Query(view.IDQuery)
+		if view.IDParam != "" {
+			storyID = c.Param(view.IDParam)
+		}
+		if storyID == "" {
+			problem.Render(c, errors.NewValidationError("story ID is required"))
+			return
+		}
+
+		responseStructure, err := builder.ParseFields(c.Query("fields"), view.Fields, view.DefaultFields)
+		if err != nil {
+			problem.Render(c, err)
+			return
+		}
+
+		ctx := c.Request.Context()
+		result, err := service.CachedStoryResponse(ctx, h.storyService, appctx.FromContext(ctx).APIVersion(),
+			strings.Join(builder.FormatFields(responseStructure), ","), storyID,
+			func(display *service.StoryDisplay) builder.StoryResponse {
+				return builder.BuildStoryResponse(display.Story, display.Author, responseStructure)
+			},
+		)
+		if err != nil {
+			problem.Render(c, err)
+			return
+		}
+
+		storyResponse, warnings := result.Response, result.Warnings
+		// Sections backed by other services are only loaded when selected, and leave the
+		// response partial rather than failing it
+		if coverStruct, ok := responseStructure["coverImage"].(map[string]interface{}); ok {
+			coverImage, warning := service.LoadSection(ctx, "coverImage", func(ctx context.Context) (*mediadomain.Media, error) {
+				return h.mediaService.GetStoryCoverImage(ctx, storyID)
+			})
+			if warning != nil {
+				warnings = append(warnings, *warning)
+			} else {
+				storyResponse.CoverImage = builder.BuildImageResponse(coverImage, coverStruct)
+			}
+		}
+		if progressStruct, ok := responseStructure["progress"].(map[string]interface{}); ok {
+			progress, warning := service.LoadSection(ctx, "progress", func(ctx context.Context) (*progressdomain.ReadingProgress, error) {
+				return h.progressService.GetStoryProgress(ctx, storyID)
+			})
+			if warning != nil {
+				warnings = append(warnings, *warning)
+			} else {
+				storyResponse.Progress = builder.BuildProgressResponse(progress, progressStruct)
+			}
+		}
+		if _, ok := responseStructure["saved"]; ok {
+			saved, warning := service.LoadSection(ctx, "saved", func(ctx context.Context) (bool, error) {
+				return h.readingListService.IsStorySaved(ctx, storyID)
+			})
+			if warning != nil {
+				warnings = append(warnings, *warning)
+			} else {
+				storyResponse.Saved = &saved
+			}
+		}
+		for _, warning := range warnings {
+			if _, ok := responseStructure[warning.Section]; ok {
+				storyResponse.AddWarning(warning.Section, warning.Reason)
+			}
+		}
+		// Last-Modified only covers the story and its author, so it is left out when
+		// other sections are selected and clients revalidate with the ETag alone
+		lastModified := result.LastModified
+		for _, section := range []string{"coverImage", "progress", "saved"} {
+			if _, ok := responseStructure[section]; ok {
+				lastModified = time.Time{}
+			}
+		}
+		httpcache.WriteJSON(c, storyResponse, lastModified)
+	}
+}
@@ -0,0 +1,425 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"go-monolith/internal/bff/handler/builder"
+	"go-monolith/internal/bff/service"
+	"go-monolith/pkg/errors"
+	"go-monolith/pkg/problem"
+)
+
+type AuthorHandler struct {
+	authorService    *service.AuthorService
+	analyticsService *service.AnalyticsService
+}
+
+var authorHandler *AuthorHandler
+
+func NewAuthorHandler(aus *service.AuthorService, as *service.AnalyticsService) *AuthorHandler {
+	if authorHandler == nil {
+		authorHandler = &AuthorHandler{
+			authorService:    aus,
+			analyticsService: as,
+		}
+	}
+	return authorHandler
+}
+
+// createAuthorRequest is the body of POST /v2.0/authors. A slug is generated from
+// the name when none is given.
+type createAuthorRequest struct {
+	FirstName       string `json:"firstName" binding:"required,min=3"`
+	LastName        string `json:"lastName" binding:"required"`
+	ProfileImageURL string `json:"profileImageUrl" binding:"required,url"`
+	Slug            string `json:"slug" binding:"omitempty,min=8"`
+}
+
+// updateAuthorRequest is the body of PUT /v2.0/authors/:id
+type updateAuthorRequest struct {
+	FirstName       string `json:"firstName" binding:"required,min=3"`
+	LastName        string `json:"lastName" binding:"required"`
+	ProfileImageURL string `json:"profileImageUrl" binding:"required,url"`
+}
+
+// changeSlugRequest is the body of PUT /v2.0/authors/:id/slug
+type changeSlugRequest struct {
+	Slug string `json:"slug" binding:"required"`
+}
+
+// authorFields are the author fields a request may select with ?fields=
+var authorFields = builder.ResponseStructure{
+	"id":              true,
+	"name":            true,
+	"slug":            true,
+	"profileImageUrl": true,
+	"profilePageUrl":  true,
+	"bio":             true,
+	"location":        true,
+	"links":           true,
+	"verified":        true,
+	"verifiedBy":      true,
+	"verifiedAt":      true,
+	"storyCount":      true,
+	"createdAt":       true,
+}
+
+// authorStructure is returned for an author when the request does not select fields
+var authorStructure = builder.ResponseStructure{
+	"id":              true,
+	"name":            true,
+	"slug":            true,
+	"profileImageUrl": true,
+	"profilePageUrl":  true,
+	"bio":             true,
+	"location":        true,
+	"links":           true,
+	"verified":        true,
+	"verifiedBy":      true,
+	"verifiedAt":      true,
+	"createdAt":       true,
+}
+
+// authorListStructure is returned for each author of a list that does not select fields
+var authorListStructure = builder.ResponseStructure{
+	"id":              true,
+	"name":            true,
+	"slug":            true,
+	"profileImageUrl": true,
+	"profilePageUrl":  true,
+	"verified":        true,
+	"storyCount":      true,
+	"createdAt":       true,
+}
+
+// authorStatsStructure selects the story aggregates returned with ?include=stats
+var authorStatsStructure = map[string]interface{}{
+	"publishedStories":  true,
+	"totalViews":        true,
+	"totalLikes":        true,
+	"firstPublishedAt":  true,
+	"latestPublishedAt": true,
+}
+
+// withStats extends structure with the author aggregates when the request asks for them
+func withStats(c *gin.Context, structure builder.ResponseStructure) (builder.ResponseStructure, bool) {
+	if c.Query("include") != "stats" {
+		return structure, false
+	}
+	extended := make(builder.ResponseStructure, len(structure)+1)
+	for key, value := range structure {
+		extended[key] = value
+	}
+	extended["stats"] = authorStatsStructure
+	return extended, true
+}
+
+// mergeRequest is the body of POST /v2.0/authors/:id/merge
+type mergeRequest struct {
+	TargetID uint `json:"targetId" binding:"required"`
+	DryRun   bool `json:"dryRun"`
+}
+
+// addOwnerRequest is the body of POST /v2.0/authors/:id/owners
+type addOwnerRequest struct {
+	UserID string `json:"userId" binding:"required"`
+}
+
+// updateProfileRequest is the body of PUT /v2.0/authors/:id/profile
+type updateProfileRequest struct {
+	Bio      string        `json:"bio"`
+	Location string        `json:"location"`
+	Links    []linkRequest `json:"links"`
+}
+
+type linkRequest struct {
+	Kind string `json:"kind" binding:"required"`
+	URL  string `json:"url" binding:"required"`
+}
+
+// GetAuthor handles GET /v2.0/authors/:id?fields=id,name&include=stats
+func (h *AuthorHandler) GetAuthor(c *gin.Context) {
+	author, err := h.authorService.GetAuthor(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		problem.Render(c, err)
+		return
+	}
+
+	selected, err := builder.ParseFields(c.Query("fields"), authorFields, authorStructure)
+	if err != nil {
+		problem.Render(c, err)
+		return
+	}
+	structure, includeStats := withStats(c, selected)
+	if includeStats {
+		if err := h.authorService.AttachStats(c.Request.Context(), author); err != nil {
+			problem.Render(c, err)
+			return
+		}
+	}
+	c.JSON(http.StatusOK, builder.BuildAuthorResponse(author, structure))
+}
+
+// CreateAuthor handles POST /v2.0/authors, creating an author managed by the caller
+func (h *AuthorHandler) CreateAuthor(c *gin.Context) {
+	var req createAuthorRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		problem.Render(c, requestError(&req, err))
+		return
+	}
+
+	author, err := h.authorService.CreateAuthor(c.Request.Context(), req.FirstName, req.LastName, req.ProfileImageURL, req.Slug)
+	if err != nil {
+		problem.Render(c, err)
+		return
+	}
+	c.Header("Location", strings.TrimSuffix(c.Request.URL.Path, "/")+"/"+strconv.FormatUint(uint64(author.ID), 10))
+	c.JSON(http.StatusCreated, builder.BuildAuthorResponse(author, authorStructure))
+}
+
+// UpdateAuthor handles PUT /v2.0/authors/:id, replacing the name and profile image
+func (h *AuthorHandler) UpdateAuthor(c *gin.Context) {
+	var req updateAuthorRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		problem.Render(c, requestError(&req, err))
+		return
+	}
+
+	author, err := h.authorService.UpdateAuthor(c.Request.Context(), c.Param("id"), req.FirstName, req.LastName, req.ProfileImageURL)
+	if err != nil {
+		problem.Render(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, builder.BuildAuthorResponse(author, authorStructure))
+}
+
+// DeleteAuthor handles DELETE /v2.0/authors/:id. The configured delete policy decides
+// whether the author's stories block the deletion, move to another author or are
+// deleted with it.
+func (h *AuthorHandler) DeleteAuthor(c *gin.Context) {
+	if err := h.authorService.DeleteAuthor(c.Request.Context(), c.Param("id")); err != nil {
+		problem.Render(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// UpdateProfile handles PUT /v2.0/authors/:id/profile
+func (h *AuthorHandler) UpdateProfile(c *gin.Context) {
+	var req updateProfileRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		problem.Render(c, errors.NewValidationError("invalid request body"))
+		return
+	}
+
+	links := make([]service.ProfileLink, len(req.Links))
+	for i, link := range req.Links {
+		links[i] = service.ProfileLink{Kind: link.Kind, URL: link.URL}
+	}
+
+	author, err := h.authorService.UpdateProfile(c.Request.Context(), c.Param("id"), req.Bio, req.Location, links)
+	if err != nil {
+		problem.Render(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, builder.BuildAuthorResponse(author, authorStructure))
+}
+
+// VerifyAuthor handles POST /v2.0/authors/:id/verification
+func (h *AuthorHandler) VerifyAuthor(c *gin.Context) {
+	author, err := h.authorService.Verify(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		problem.Render(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, builder.BuildAuthorResponse(author, authorStructure))
+}
+
+// UnverifyAuthor handles DELETE /v2.0/authors/:id/verification
+func (h *AuthorHandler) UnverifyAuthor(c *gin.Context) {
+	author, err := h.authorService.Unverify(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		problem.Render(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, builder.BuildAuthorResponse(author, authorStructure))
+}
+
+// GetAuthorBySlug handles GET /v2.0/authors/slug/:slug?fields=id,name&include=stats. Previous slugs redirect
+// permanently to the author's current slug.
+func (h *AuthorHandler) GetAuthorBySlug(c *gin.Context) {
+	slug := c.Param("slug")
+	author, moved, err := h.authorService.GetAuthorBySlug(c.Request.Context(), slug)
+	if err != nil {
+		problem.Render(c, err)
+		return
+	}
+	if moved {
+		// The redirect keeps the path the request used, versioned or negotiated
+		location := strings.TrimSuffix(c.Request.URL.Path, slug) + author.Slug
+		if c.Request.URL.RawQuery != "" {
+			location += "?" + c.Request.URL.RawQuery
+		}
+		c.Redirect(http.StatusMovedPermanently, location)
+		return
+	}
+
+	selected, err := builder.ParseFields(c.Query("fields"), authorFields, authorStructure)
+	if err != nil {
+		problem.Render(c, err)
+		return
+	}
+	structure, includeStats := withStats(c, selected)
+	if includeStats {
+		if err := h.authorService.AttachStats(c.Request.Context(), author); err != nil {
+			problem.Render(c, err)
+			return
+		}
+	}
+	c.JSON(http.StatusOK, builder.BuildAuthorResponse(author, structure))
+}
+
+// ChangeSlug handles PUT /v2.0/authors/:id/slug
+func (h *AuthorHandler) ChangeSlug(c *gin.Context) {
+	var req changeSlugRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		problem.Render(c, errors.NewValidationError("slug is required"))
+		return
+	}
+
+	author, err := h.authorService.ChangeSlug(c.Request.Context(), c.Param("id"), req.Slug)
+	if err != nil {
+		problem.Render(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, builder.BuildAuthorResponse(author, authorStructure))
+}
+
+// MergeAuthor handles POST /v2.0/authors/:id/merge, merging the duplicate author
+// into targetId. With dryRun the response only previews what would move.
+func (h *AuthorHandler) MergeAuthor(c *gin.Context) {
+	var req mergeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		problem.Render(c, errors.NewValidationError("targetId is required"))
+		return
+	}
+
+	plan, err := h.authorService.MergeAuthors(c.Request.Context(),
+		c.Param("id"), strconv.FormatUint(uint64(req.TargetID), 10), req.DryRun)
+	if err != nil {
+		problem.Render(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, builder.BuildMergeResponse(plan))
+}
+
+// ListMyAuthors handles GET /v2.0/me/authors?fields=id,name&include=stats
+func (h *AuthorHandler) ListMyAuthors(c *gin.Context) {
+	authors, err := h.authorService.ListMyAuthors(c.Request.Context())
+	if err != nil {
+		problem.Render(c, err)
+		return
+	}
+
+	selected, err := builder.ParseFields(c.Query("fields"), authorFields, authorStructure)
+	if err != nil {
+		problem.Render(c, err)
+		return
+	}
+	structure, includeStats := withStats(c, selected)
+	if includeStats {
+		if err := h.authorService.AttachStats(c.Request.Context(), authors...); err != nil {
+			problem.Render(c, err)
+			return
+		}
+	}
+	resp := make([]builder.AuthorResponse, len(authors))
+	for i, author := range authors {
+		resp[i] = builder.BuildAuthorResponse(author, structure)
+	}
+	c.JSON(http.StatusOK, gin.H{"authors": resp})
+}
+
+// AddOwner handles POST /v2.0/authors/:id/owners
+func (h *AuthorHandler) AddOwner(c *gin.Context) {
+	var req addOwnerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		problem.Render(c, errors.NewValidationError("userId is required"))
+		return
+	}
+
+	if err := h.authorService.AddOwner(c.Request.Context(), c.Param("id"), req.UserID); err != nil {
+		problem.Render(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// RemoveOwner handles DELETE /v2.0/authors/:id/owners/:userId
+func (h *AuthorHandler) RemoveOwner(c *gin.Context) {
+	if err := h.authorService.RemoveOwner(c.Request.Context(), c.Param("id"), c.Param("userId")); err != nil {
+		problem.Render(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// ListAuthors handles GET /v2.0/authors?q=jo&sort=name|createdAt|storyCount&order=asc|desc&cursor=...&limit=20&fields=id,name&include=stats
+func (h *AuthorHandler) ListAuthors(c *gin.Context) {
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "0"))
+	if err != nil {
+		problem.Render(c, errors.NewValidationError("invalid limit"))
+		return
+	}
+
+	page, err := h.authorService.ListAuthors(c.Request.Context(),
+		c.Query("q"), c.Query("sort"), c.Query("order"), c.Query("cursor"), limit)
+	if err != nil {
+		problem.Render(c, err)
+		return
+	}
+
+	selected, err := builder.ParseFields(c.Query("fields"), authorFields, authorListStructure)
+	if err != nil {
+		problem.Render(c, err)
+		return
+	}
+	responseStructure, includeStats := withStats(c, selected)
+	if includeStats {
+		if err := h.authorService.AttachStats(c.Request.Context(), page.Authors...); err != nil {
+			problem.Render(c, err)
+			return
+		}
+	}
+	authors := make([]builder.AuthorResponse, len(page.Authors))
+	for i, author := range page.Authors {
+		authors[i] = builder.BuildAuthorResponse(author, responseStructure)
+	}
+
+	resp := gin.H{"authors": authors}
+	if page.Next != nil {
+		resp["nextCursor"] = page.Next.Encode()
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// GetAuthorStats handles GET /v2.0/authors/:id/stats?from=2025-01-01&to=2025-01-31
+func (h *AuthorHandler) GetAuthorStats(c *gin.Context) {
+	authorID := c.Param("id")
+	if authorID == "" {
+		problem.Render(c, errors.NewValidationError("author ID is required"))
+		return
+	}
+
+	stats, err := h.analyticsService.GetAuthorStats(c.Request.Context(), authorID, c.Query("from"), c.Query("to"))
+	if err != nil {
+		problem.Render(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, builder.BuildAuthorStatsResponse(stats))
+}
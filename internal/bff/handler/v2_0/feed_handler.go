@@ -0,0 +1,66 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"go-monolith/internal/bff/handler/builder"
+	"go-monolith/internal/bff/service"
+	"go-monolith/pkg/errors"
+	"go-monolith/pkg/problem"
+)
+
+type FeedHandler struct {
+	feedService *service.FeedService
+}
+
+var feedHandler *FeedHandler
+
+func NewFeedHandler(fs *service.FeedService) *FeedHandler {
+	if feedHandler == nil {
+		feedHandler = &FeedHandler{
+			feedService: fs,
+		}
+	}
+	return feedHandler
+}
+
+// GetFeed handles GET /v2.0/feed?cursor=...&limit=20
+func (h *FeedHandler) GetFeed(c *gin.Context) {
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "0"))
+	if err != nil {
+		problem.Render(c, errors.NewValidationError("invalid limit"))
+		return
+	}
+
+	page, err := h.feedService.GetFeed(c.Request.Context(), c.Query("cursor"), limit)
+	if err != nil {
+		problem.Render(c, err)
+		return
+	}
+
+	responseStructure := builder.ResponseStructure{
+		"id":          true,
+		"title":       true,
+		"publishedAt": true,
+		"author": map[string]interface{}{
+			"name":            true,
+			"profileImageUrl": true,
+		},
+	}
+	stories := make([]builder.StoryResponse, len(page.Items))
+	for i, item := range page.Items {
+		stories[i] = builder.BuildStoryResponse(item.Story, item.Author, responseStructure)
+	}
+
+	resp := gin.H{
+		"stories": stories,
+		"source":  page.Source,
+	}
+	if page.NextCursor != "" {
+		resp["nextCursor"] = page.NextCursor
+	}
+	c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,56 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"go-monolith/internal/bff/service"
+	"go-monolith/pkg/errors"
+	"go-monolith/pkg/problem"
+)
+
+type FollowHandler struct {
+	followService *service.FollowService
+}
+
+var followHandler *FollowHandler
+
+func NewFollowHandler(fs *service.FollowService) *FollowHandler {
+	if followHandler == nil {
+		followHandler = &FollowHandler{
+			followService: fs,
+		}
+	}
+	return followHandler
+}
+
+// FollowAuthor handles POST /v2.0/authors/:id/follow
+func (h *FollowHandler) FollowAuthor(c *gin.Context) {
+	authorID := c.Param("id")
+	if authorID == "" {
+		problem.Render(c, errors.NewValidationError("author ID is required"))
+		return
+	}
+
+	if err := h.followService.FollowAuthor(c.Request.Context(), authorID); err != nil {
+		problem.Render(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"authorId": authorID, "following": true})
+}
+
+// UnfollowAuthor handles DELETE /v2.0/authors/:id/follow
+func (h *FollowHandler) UnfollowAuthor(c *gin.Context) {
+	authorID := c.Param("id")
+	if authorID == "" {
+		problem.Render(c, errors.NewValidationError("author ID is required"))
+		return
+	}
+
+	if err := h.followService.UnfollowAuthor(c.Request.Context(), authorID); err != nil {
+		problem.Render(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"go-monolith/internal/bff/handler/builder"
+	"go-monolith/internal/bff/service"
+	"go-monolith/pkg/errors"
+	"go-monolith/pkg/problem"
+)
+
+type MediaHandler struct {
+	mediaService *service.MediaService
+}
+
+var mediaHandler *MediaHandler
+
+func NewMediaHandler(ms *service.MediaService) *MediaHandler {
+	if mediaHandler == nil {
+		mediaHandler = &MediaHandler{
+			mediaService: ms,
+		}
+	}
+	return mediaHandler
+}
+
+// UploadStoryMedia handles POST /v2.0/stories/:id/media
+// Expects a multipart form with a "file" part and an optional "kind" (cover or inline, default cover)
+func (h *MediaHandler) UploadStoryMedia(c *gin.Context) {
+	storyID := c.Param("id")
+	if storyID == "" {
+		problem.Render(c, errors.NewValidationError("story ID is required"))
+		return
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		problem.Render(c, errors.NewValidationError("file is required"))
+		return
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		problem.Render(c, errors.NewValidationError("failed to read uploaded file"))
+		return
+	}
+	defer file.Close()
+
+	kind := c.DefaultPostForm("kind", "cover")
+	media, err := h.mediaService.UploadStoryImage(c.Request.Context(), storyID, kind, file)
+	if err != nil {
+		problem.Render(c, err)
+		return
+	}
+
+	imageStructure := map[string]interface{}{
+		"url":        true,
+		"width":      true,
+		"height":     true,
+		"thumbnails": true,
+	}
+	c.JSON(http.StatusCreated, gin.H{
+		"id":    media.ID,
+		"kind":  media.Kind,
+		"image": builder.BuildImageResponse(media, imageStructure),
+	})
+}
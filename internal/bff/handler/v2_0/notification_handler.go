@@ -0,0 +1,84 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"go-monolith/internal/bff/handler/builder"
+	"go-monolith/internal/bff/service"
+	"go-monolith/pkg/errors"
+	"go-monolith/pkg/problem"
+)
+
+type NotificationHandler struct {
+	notificationService *service.NotificationService
+}
+
+var notificationHandler *NotificationHandler
+
+func NewNotificationHandler(ns *service.NotificationService) *NotificationHandler {
+	if notificationHandler == nil {
+		notificationHandler = &NotificationHandler{
+			notificationService: ns,
+		}
+	}
+	return notificationHandler
+}
+
+// ListNotifications handles GET /v2.0/me/notifications?unread=true&limit=20&offset=0
+func (h *NotificationHandler) ListNotifications(c *gin.Context) {
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "0"))
+	if err != nil {
+		problem.Render(c, errors.NewValidationError("invalid limit"))
+		return
+	}
+	offset, err := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	if err != nil {
+		problem.Render(c, errors.NewValidationError("invalid offset"))
+		return
+	}
+	unreadOnly := c.Query("unread") == "true"
+
+	notifications, err := h.notificationService.ListNotifications(c.Request.Context(), unreadOnly, limit, offset)
+	if err != nil {
+		problem.Render(c, err)
+		return
+	}
+
+	resp := make([]builder.NotificationResponse, len(notifications))
+	for i, n := range notifications {
+		resp[i] = builder.BuildNotificationResponse(n)
+	}
+	c.JSON(http.StatusOK, gin.H{"notifications": resp})
+}
+
+// UnreadCount handles GET /v2.0/me/notifications/unread-count
+func (h *NotificationHandler) UnreadCount(c *gin.Context) {
+	count, err := h.notificationService.UnreadCount(c.Request.Context())
+	if err != nil {
+		problem.Render(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"unread": count})
+}
+
+// MarkRead handles POST /v2.0/me/notifications/:id/read
+func (h *NotificationHandler) MarkRead(c *gin.Context) {
+	if err := h.notificationService.MarkRead(c.Request.Context(), c.Param("id")); err != nil {
+		problem.Render(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// MarkAllRead handles POST /v2.0/me/notifications/read-all
+func (h *NotificationHandler) MarkAllRead(c *gin.Context) {
+	count, err := h.notificationService.MarkAllRead(c.Request.Context())
+	if err != nil {
+		problem.Render(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"marked": count})
+}
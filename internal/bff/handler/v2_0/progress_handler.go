@@ -0,0 +1,116 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"go-monolith/internal/bff/handler/builder"
+	"go-monolith/internal/bff/service"
+	"go-monolith/pkg/errors"
+	"go-monolith/pkg/problem"
+)
+
+type ProgressHandler struct {
+	progressService *service.ProgressService
+}
+
+var progressHandler *ProgressHandler
+
+func NewProgressHandler(ps *service.ProgressService) *ProgressHandler {
+	if progressHandler == nil {
+		progressHandler = &ProgressHandler{
+			progressService: ps,
+		}
+	}
+	return progressHandler
+}
+
+// saveProgressRequest is the body of PUT /v2.0/stories/:id/progress
+type saveProgressRequest struct {
+	Percent *float64 `json:"percent"`
+	Offset  *int64   `json:"offset"`
+}
+
+var progressStructure = map[string]interface{}{
+	"percent":    true,
+	"offset":     true,
+	"lastReadAt": true,
+}
+
+// SaveProgress handles PUT /v2.0/stories/:id/progress
+func (h *ProgressHandler) SaveProgress(c *gin.Context) {
+	storyID := c.Param("id")
+	if storyID == "" {
+		problem.Render(c, errors.NewValidationError("story ID is required"))
+		return
+	}
+
+	var req saveProgressRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		problem.Render(c, errors.NewValidationError("invalid request body"))
+		return
+	}
+
+	progress, err := h.progressService.SaveProgress(c.Request.Context(), storyID, req.Percent, req.Offset)
+	if err != nil {
+		problem.Render(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, builder.BuildProgressResponse(progress, progressStructure))
+}
+
+// GetProgress handles GET /v2.0/stories/:id/progress
+func (h *ProgressHandler) GetProgress(c *gin.Context) {
+	storyID := c.Param("id")
+	if storyID == "" {
+		problem.Render(c, errors.NewValidationError("story ID is required"))
+		return
+	}
+
+	progress, err := h.progressService.GetStoryProgress(c.Request.Context(), storyID)
+	if err != nil {
+		problem.Render(c, err)
+		return
+	}
+	if progress == nil {
+		problem.Render(c, errors.NewNotFoundError("reading progress", storyID))
+		return
+	}
+
+	c.JSON(http.StatusOK, builder.BuildProgressResponse(progress, progressStructure))
+}
+
+// ListContinueReading handles GET /v2.0/me/continue-reading?limit=10
+func (h *ProgressHandler) ListContinueReading(c *gin.Context) {
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "0"))
+	if err != nil {
+		problem.Render(c, errors.NewValidationError("invalid limit"))
+		return
+	}
+
+	items, err := h.progressService.ContinueReading(c.Request.Context(), limit)
+	if err != nil {
+		problem.Render(c, err)
+		return
+	}
+
+	responseStructure := builder.ResponseStructure{
+		"id":    true,
+		"title": true,
+		"author": map[string]interface{}{
+			"name":            true,
+			"profileImageUrl": true,
+		},
+		"progress": progressStructure,
+	}
+	stories := make([]builder.StoryResponse, len(items))
+	for i, item := range items {
+		stories[i] = builder.BuildStoryResponse(item.Story, item.Author, responseStructure)
+		stories[i].Progress = builder.BuildProgressResponse(item.Progress, progressStructure)
+	}
+
+	c.JSON(http.StatusOK, gin.H{"stories": stories})
+}
@@ -0,0 +1,180 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"go-monolith/internal/bff/handler/builder"
+	"go-monolith/internal/bff/service"
+	"go-monolith/pkg/errors"
+	"go-monolith/pkg/problem"
+)
+
+type ReadingListHandler struct {
+	readingListService *service.ReadingListService
+}
+
+var readingListHandler *ReadingListHandler
+
+func NewReadingListHandler(rs *service.ReadingListService) *ReadingListHandler {
+	if readingListHandler == nil {
+		readingListHandler = &ReadingListHandler{
+			readingListService: rs,
+		}
+	}
+	return readingListHandler
+}
+
+// readingListRequest is the body of POST /v2.0/me/lists and PUT /v2.0/lists/:id
+type readingListRequest struct {
+	Name       string `json:"name" binding:"required"`
+	Visibility string `json:"visibility"`
+}
+
+// addStoryRequest is the body of POST /v2.0/lists/:id/stories
+type addStoryRequest struct {
+	StoryID string `json:"storyId" binding:"required"`
+}
+
+// reorderRequest is the body of PUT /v2.0/lists/:id/order
+type reorderRequest struct {
+	StoryIDs []string `json:"storyIds" binding:"required"`
+}
+
+var listStoryStructure = builder.ResponseStructure{
+	"id":    true,
+	"title": true,
+}
+
+// ListMyLists handles GET /v2.0/me/lists
+func (h *ReadingListHandler) ListMyLists(c *gin.Context) {
+	lists, err := h.readingListService.ListMyLists(c.Request.Context())
+	if err != nil {
+		problem.Render(c, err)
+		return
+	}
+
+	resp := make([]builder.ReadingListResponse, len(lists))
+	for i, list := range lists {
+		resp[i] = builder.BuildReadingListResponse(list, nil, nil)
+	}
+	c.JSON(http.StatusOK, gin.H{"lists": resp})
+}
+
+// CreateList handles POST /v2.0/me/lists
+func (h *ReadingListHandler) CreateList(c *gin.Context) {
+	var req readingListRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		problem.Render(c, errors.NewValidationError("name is required"))
+		return
+	}
+
+	list, err := h.readingListService.CreateList(c.Request.Context(), req.Name, visibilityOrDefault(req.Visibility))
+	if err != nil {
+		problem.Render(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, builder.BuildReadingListResponse(list, nil, nil))
+}
+
+// GetList handles GET /v2.0/lists/:id
+func (h *ReadingListHandler) GetList(c *gin.Context) {
+	details, err := h.readingListService.GetList(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		problem.Render(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, builder.BuildReadingListResponse(details.List, details.Stories, listStoryStructure))
+}
+
+// UpdateList handles PUT /v2.0/lists/:id
+func (h *ReadingListHandler) UpdateList(c *gin.Context) {
+	var req readingListRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		problem.Render(c, errors.NewValidationError("name is required"))
+		return
+	}
+
+	list, err := h.readingListService.UpdateList(c.Request.Context(), c.Param("id"), req.Name, visibilityOrDefault(req.Visibility))
+	if err != nil {
+		problem.Render(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, builder.BuildReadingListResponse(list, nil, nil))
+}
+
+// DeleteList handles DELETE /v2.0/lists/:id
+func (h *ReadingListHandler) DeleteList(c *gin.Context) {
+	if err := h.readingListService.DeleteList(c.Request.Context(), c.Param("id")); err != nil {
+		problem.Render(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// AddStory handles POST /v2.0/lists/:id/stories
+func (h *ReadingListHandler) AddStory(c *gin.Context) {
+	var req addStoryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		problem.Render(c, errors.NewValidationError("storyId is required"))
+		return
+	}
+
+	item, err := h.readingListService.AddStory(c.Request.Context(), c.Param("id"), req.StoryID)
+	if err != nil {
+		problem.Render(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"storyId": item.StoryID, "position": item.Position})
+}
+
+// RemoveStory handles DELETE /v2.0/lists/:id/stories/:storyId
+func (h *ReadingListHandler) RemoveStory(c *gin.Context) {
+	if err := h.readingListService.RemoveStory(c.Request.Context(), c.Param("id"), c.Param("storyId")); err != nil {
+		problem.Render(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// Reorder handles PUT /v2.0/lists/:id/order
+func (h *ReadingListHandler) Reorder(c *gin.Context) {
+	var req reorderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		problem.Render(c, errors.NewValidationError("storyIds is required"))
+		return
+	}
+
+	if err := h.readingListService.Reorder(c.Request.Context(), c.Param("id"), req.StoryIDs); err != nil {
+		problem.Render(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// SaveStory handles POST /v2.0/stories/:id/save
+func (h *ReadingListHandler) SaveStory(c *gin.Context) {
+	item, err := h.readingListService.SaveStory(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		problem.Render(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"storyId": item.StoryID, "saved": true})
+}
+
+// UnsaveStory handles DELETE /v2.0/stories/:id/save
+func (h *ReadingListHandler) UnsaveStory(c *gin.Context) {
+	if err := h.readingListService.UnsaveStory(c.Request.Context(), c.Param("id")); err != nil {
+		problem.Render(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func visibilityOrDefault(visibility string) string {
+	if visibility == "" {
+		return "private"
+	}
+	return visibility
+}
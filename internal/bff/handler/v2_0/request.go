@@ -0,0 +1,45 @@
+package handler
+
+import (
+	stderrors "errors"
+	"reflect"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+
+	"go-monolith/pkg/errors"
+)
+
+// requestError describes why binding the request body into req failed, naming each
+// invalid field as the client sent it
+func requestError(req interface{}, err error) error {
+	var fieldErrs validator.ValidationErrors
+	if !stderrors.As(err, &fieldErrs) {
+		return errors.NewValidationError("invalid request body")
+	}
+
+	reqType := reflect.TypeOf(req)
+	if reqType.Kind() == reflect.Ptr {
+		reqType = reqType.Elem()
+	}
+	problems := make([]string, 0, len(fieldErrs))
+	for _, fieldErr := range fieldErrs {
+		name := fieldErr.Field()
+		if field, ok := reqType.FieldByName(fieldErr.StructField()); ok {
+			if tag, _, _ := strings.Cut(field.Tag.Get("json"), ","); tag != "" {
+				name = tag
+			}
+		}
+		switch fieldErr.Tag() {
+		case "required":
+			problems = append(problems, name+" is required")
+		case "min":
+			problems = append(problems, name+" must be at least "+fieldErr.Param()+" characters long")
+		case "max":
+			problems = append(problems, name+" cannot exceed "+fieldErr.Param()+" characters")
+		default:
+			problems = append(problems, name+" is invalid")
+		}
+	}
+	return errors.NewValidationError(strings.Join(problems, "; "))
+}
@@ -2,11 +2,15 @@ package handler
 
 import (
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 
 	"go-monolith/internal/bff/handler/builder"
 	"go-monolith/internal/bff/service"
+	"go-monolith/pkg/errors"
+	"go-monolith/pkg/problem"
 )
 
 type StoryHandler struct {
@@ -24,29 +28,182 @@ func NewStoryHandler(ss *service.StoryService) *StoryHandler {
 	return storyHandler
 }
 
-// GetStory handles GET /v2.0/stories/:id
-func (h *StoryHandler) GetStory(c *gin.Context) {
+// storyBatchFields are the story fields a batch may select. Sections backed by other
+// services are left out, they would cost a lookup per story.
+var storyBatchFields = builder.ResponseStructure{
+	"id":          true,
+	"title":       true,
+	"content":     true,
+	"publishedAt": true,
+	"author": map[string]interface{}{
+		"id":              true,
+		"name":            true,
+		"slug":            true,
+		"profileImageUrl": true,
+		"profilePageUrl":  true,
+		"verified":        true,
+	},
+}
+
+// defaultStoryBatchFields are returned when a batch does not select fields
+var defaultStoryBatchFields = builder.ResponseStructure{
+	"id":          true,
+	"title":       true,
+	"content":     true,
+	"publishedAt": true,
+	"author": map[string]interface{}{
+		"name":            true,
+		"profileImageUrl": true,
+		"profilePageUrl":  true,
+		"verified":        true,
+	},
+}
+
+// storyWriteFields are returned by story writes, which do not load the author
+var storyWriteFields = builder.ResponseStructure{
+	"id":          true,
+	"title":       true,
+	"content":     true,
+	"publishedAt": true,
+}
+
+// createStoryRequest is the body of POST /v2.0/stories
+type createStoryRequest struct {
+	AuthorID uint   `json:"authorId" binding:"required"`
+	Title    string `json:"title" binding:"required,min=3,max=255"`
+	Content  string `json:"content" binding:"required,min=10"`
+}
+
+// updateStoryRequest is the body of PUT /v2.0/stories/:id
+type updateStoryRequest struct {
+	Title   string `json:"title" binding:"required,min=3,max=255"`
+	Content string `json:"content" binding:"required,min=10"`
+}
+
+// GetStories handles GET /v2.0/stories?ids=1,2,3&fields=id,title,author(name).
+// Each requested ID gets an item with its own status, so missing stories do not
+// fail the request.
+func (h *StoryHandler) GetStories(c *gin.Context) {
+	ids := c.Query("ids")
+	if ids == "" {
+		problem.Render(c, errors.NewValidationError("ids is required"))
+		return
+	}
+
+	responseStructure, err := builder.ParseFields(c.Query("fields"), storyBatchFields, defaultStoryBatchFields)
+	if err != nil {
+		problem.Render(c, err)
+		return
+	}
+
+	items, err := h.storyService.GetStoriesDisplayDetails(c.Request.Context(), strings.Split(ids, ","))
+	if err != nil {
+		problem.Render(c, err)
+		return
+	}
+
+	stories := make([]builder.StoryBatchItemResponse, len(items))
+	for i, item := range items {
+		if item.Err != nil {
+			// Items report the status and detail a whole response would, so internal
+			// errors are hidden here too
+			itemProblem := problem.New(c.Request.Context(), item.Err)
+			stories[i] = builder.StoryBatchItemResponse{
+				ID:     item.ID,
+				Status: itemProblem.Status,
+				Error:  itemProblem.Detail,
+			}
+			continue
+		}
+		storyResponse := builder.BuildStoryResponse(item.Story, item.Author, responseStructure)
+		for _, warning := range item.Warnings {
+			if _, ok := responseStructure[warning.Section]; ok {
+				storyResponse.AddWarning(warning.Section, warning.Reason)
+			}
+		}
+		stories[i] = builder.StoryBatchItemResponse{
+			ID:     item.ID,
+			Status: http.StatusOK,
+			Story:  &storyResponse,
+		}
+	}
+	c.JSON(http.StatusOK, gin.H{"stories": stories})
+}
+
+// LikeStory handles POST /v2.0/stories/:id/like
+func (h *StoryHandler) LikeStory(c *gin.Context) {
 	storyID := c.Param("id")
 	if storyID == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "story ID is required"})
+		problem.Render(c, errors.NewValidationError("story ID is required"))
+		return
+	}
+
+	story, added, err := h.storyService.LikeStory(c.Request.Context(), storyID)
+	if err != nil {
+		problem.Render(c, err)
+		return
+	}
+
+	status := http.StatusOK
+	if added {
+		status = http.StatusCreated
+	}
+	c.JSON(status, gin.H{
+		"id":    story.ID,
+		"likes": story.Likes,
+		"liked": true,
+	})
+}
+
+// CreateStory handles POST /v2.0/stories. The story is created as a draft of an
+// author the caller owns.
+func (h *StoryHandler) CreateStory(c *gin.Context) {
+	var req createStoryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		problem.Render(c, requestError(&req, err))
+		return
+	}
+
+	story, err := h.storyService.CreateStory(c.Request.Context(), req.AuthorID, req.Title, req.Content)
+	if err != nil {
+		problem.Render(c, err)
 		return
 	}
+	c.Header("Location", strings.TrimSuffix(c.Request.URL.Path, "/")+"/"+strconv.FormatUint(uint64(story.ID), 10))
+	c.JSON(http.StatusCreated, builder.BuildStoryResponse(story, nil, storyWriteFields))
+}
 
-	story, author, err := h.storyService.GetStoryDisplayDetails(c.Request.Context(), storyID)
+// UpdateStory handles PUT /v2.0/stories/:id, replacing the title and content
+func (h *StoryHandler) UpdateStory(c *gin.Context) {
+	var req updateStoryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		problem.Render(c, requestError(&req, err))
+		return
+	}
+
+	story, err := h.storyService.UpdateStory(c.Request.Context(), c.Param("id"), req.Title, req.Content)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		problem.Render(c, err)
 		return
 	}
+	c.JSON(http.StatusOK, builder.BuildStoryResponse(story, nil, storyWriteFields))
+}
 
-	responseStructure := builder.ResponseStructure{
-		"id":      true,
-		"title":   true,
-		"content": true,
-		"author": map[string]interface{}{
-			"name":            true,
-			"profileImageUrl": true,
-		},
+// PublishStory handles POST /v2.0/stories/:id/publish
+func (h *StoryHandler) PublishStory(c *gin.Context) {
+	story, err := h.storyService.PublishStory(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		problem.Render(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, builder.BuildStoryResponse(story, nil, storyWriteFields))
+}
+
+// DeleteStory handles DELETE /v2.0/stories/:id
+func (h *StoryHandler) DeleteStory(c *gin.Context) {
+	if err := h.storyService.DeleteStory(c.Request.Context(), c.Param("id")); err != nil {
+		problem.Render(c, err)
+		return
 	}
-	storyResponse := builder.BuildStoryResponse(story, author, responseStructure)
-	c.JSON(http.StatusOK, storyResponse)
+	c.Status(http.StatusNoContent)
 }
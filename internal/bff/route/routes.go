@@ -6,6 +6,7 @@ import (
 	"github.com/gin-gonic/gin"
 
 	"go-monolith/internal/bff/handler"
+	"go-monolith/internal/bff/version"
 	"go-monolith/pkg/auth"
 )
 
@@ -25,29 +26,14 @@ func SetupPublicRoutes(router gin.IRouter) {
 
 // SetupProtectedRoutes configures all routes that require authentication
 func SetupProtectedRoutes(router gin.IRouter, handlers *handler.Handlers, permissionVerifier auth.PermissionVerifier) {
-	// v1.2 routes
-	router.POST("/v1.2/authors",
-		auth.RequirePermission(permissionVerifier, "create", "author"),
-		func(c *gin.Context) {
-			c.JSON(http.StatusNotImplemented, gin.H{"message": "not implemented"})
-		},
-	)
-
-	router.GET("/v1.2/stories",
-		auth.RequirePermission(permissionVerifier, "get", "story"),
-		handlers.V1_2StoryHandler.GetStory,
-	)
+	// Versioned routes are served under their version's prefix and, negotiated by the
+	// X-API-Version header or Accept media type, without one
+	version.NewRegistry(apiVersions(handlers)...).Mount(router, permissionVerifier)
 
-	// v2.0 routes
-	router.GET("/v2.0/stories/:id",
+	// GraphQL reads stories and authors, so it needs the permissions of both REST reads
+	router.POST("/graphql",
 		auth.RequirePermission(permissionVerifier, "get", "story"),
-		handlers.V2_0StoryHandler.GetStory,
-	)
-
-	router.DELETE("/v2.0/stories/:id",
-		auth.RequirePermission(permissionVerifier, "delete", "story"),
-		func(c *gin.Context) {
-			c.JSON(http.StatusNotImplemented, gin.H{"message": "not implemented"})
-		},
+		auth.RequirePermission(permissionVerifier, "get", "author"),
+		handlers.GraphQLHandler.Query,
 	)
 }
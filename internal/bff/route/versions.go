@@ -0,0 +1,149 @@
+package routes
+
+import (
+	"time"
+
+	"go-monolith/internal/bff/handler"
+	"go-monolith/internal/bff/handler/builder"
+	"go-monolith/internal/bff/version"
+)
+
+// v1_2StoryFields are the story fields v1.2 exposes, which are also returned by default
+var v1_2StoryFields = builder.ResponseStructure{
+	"id":    true,
+	"title": true,
+	"author": map[string]interface{}{
+		"name":            true,
+		"profileImageUrl": true,
+	},
+}
+
+// v2_0StoryFields are the story fields v2.0 exposes
+var v2_0StoryFields = builder.ResponseStructure{
+	"id":          true,
+	"title":       true,
+	"content":     true,
+	"publishedAt": true,
+	"author": map[string]interface{}{
+		"id":              true,
+		"name":            true,
+		"slug":            true,
+		"profileImageUrl": true,
+		"profilePageUrl":  true,
+		"verified":        true,
+	},
+	"coverImage": map[string]interface{}{
+		"url":        true,
+		"width":      true,
+		"height":     true,
+		"thumbnails": true,
+	},
+	"progress": map[string]interface{}{
+		"percent":    true,
+		"offset":     true,
+		"lastReadAt": true,
+	},
+	"saved": true,
+}
+
+// v2_0DefaultStoryFields are returned by v2.0 when the client does not select fields
+var v2_0DefaultStoryFields = builder.ResponseStructure{
+	"id":      true,
+	"title":   true,
+	"content": true,
+	"author": map[string]interface{}{
+		"name":            true,
+		"profileImageUrl": true,
+		"profilePageUrl":  true,
+		"verified":        true,
+	},
+	"coverImage": map[string]interface{}{
+		"url":        true,
+		"width":      true,
+		"height":     true,
+		"thumbnails": true,
+	},
+	"progress": map[string]interface{}{
+		"percent":    true,
+		"offset":     true,
+		"lastReadAt": true,
+	},
+	"saved": true,
+}
+
+// apiVersions declares the API versions, oldest first. A new version is usually
+// derived from the latest one, replacing only the routes whose request or response
+// changes, e.g. version.Derive(v2_0, "v2.1", version.GET("/stories/:id", ...)).
+func apiVersions(handlers *handler.Handlers) []version.Version {
+	// v1.2 is being retired; its sunset date is configured with BFF_VERSION_SUNSETS
+	v1_2 := version.Version{
+		Name: "v1.2",
+		Deprecation: &version.Deprecation{
+			Since:     time.Date(2026, time.October, 18, 0, 0, 0, 0, time.UTC),
+			Migration: "use v2.0: read a story with GET /v2.0/stories/:id, whose default fields include those of v1.2",
+		},
+		Routes: []version.Route{
+			version.POST("/authors", "create", "author", handlers.V2_0AuthorHandler.CreateAuthor),
+			version.GET("/stories", "get", "story", handlers.StoryViewHandler.GetStory(handler.StoryView{
+				IDQuery:       "id",
+				Fields:        v1_2StoryFields,
+				DefaultFields: v1_2StoryFields,
+			})),
+		},
+	}
+
+	v2_0 := version.Version{
+		Name: "v2.0",
+		Routes: []version.Route{
+			version.GET("/stories", "get", "story", handlers.V2_0StoryHandler.GetStories),
+			version.POST("/stories", "create", "story", handlers.V2_0StoryHandler.CreateStory),
+			version.GET("/stories/:id", "get", "story", handlers.StoryViewHandler.GetStory(handler.StoryView{
+				IDParam:       "id",
+				Fields:        v2_0StoryFields,
+				DefaultFields: v2_0DefaultStoryFields,
+			})),
+			version.PUT("/stories/:id", "update", "story", handlers.V2_0StoryHandler.UpdateStory),
+			version.POST("/stories/:id/publish", "publish", "story", handlers.V2_0StoryHandler.PublishStory),
+			version.DELETE("/stories/:id", "delete", "story", handlers.V2_0StoryHandler.DeleteStory),
+			version.POST("/stories/:id/media", "update", "story", handlers.V2_0MediaHandler.UploadStoryMedia),
+			version.POST("/stories/:id/like", "like", "story", handlers.V2_0StoryHandler.LikeStory),
+			version.PUT("/stories/:id/progress", "update", "reading_progress", handlers.V2_0ProgressHandler.SaveProgress),
+			version.GET("/stories/:id/progress", "get", "reading_progress", handlers.V2_0ProgressHandler.GetProgress),
+			version.GET("/me/continue-reading", "get", "reading_progress", handlers.V2_0ProgressHandler.ListContinueReading),
+			version.POST("/stories/:id/save", "update", "reading_list", handlers.V2_0ReadingListHandler.SaveStory),
+			version.DELETE("/stories/:id/save", "update", "reading_list", handlers.V2_0ReadingListHandler.UnsaveStory),
+			version.GET("/me/lists", "get", "reading_list", handlers.V2_0ReadingListHandler.ListMyLists),
+			version.POST("/me/lists", "create", "reading_list", handlers.V2_0ReadingListHandler.CreateList),
+			version.GET("/lists/:id", "get", "reading_list", handlers.V2_0ReadingListHandler.GetList),
+			version.PUT("/lists/:id", "update", "reading_list", handlers.V2_0ReadingListHandler.UpdateList),
+			version.DELETE("/lists/:id", "delete", "reading_list", handlers.V2_0ReadingListHandler.DeleteList),
+			version.POST("/lists/:id/stories", "update", "reading_list", handlers.V2_0ReadingListHandler.AddStory),
+			version.DELETE("/lists/:id/stories/:storyId", "update", "reading_list", handlers.V2_0ReadingListHandler.RemoveStory),
+			version.PUT("/lists/:id/order", "update", "reading_list", handlers.V2_0ReadingListHandler.Reorder),
+			version.GET("/authors", "get", "author", handlers.V2_0AuthorHandler.ListAuthors),
+			version.POST("/authors", "create", "author", handlers.V2_0AuthorHandler.CreateAuthor),
+			version.GET("/authors/:id", "get", "author", handlers.V2_0AuthorHandler.GetAuthor),
+			version.PUT("/authors/:id", "update", "author", handlers.V2_0AuthorHandler.UpdateAuthor),
+			version.DELETE("/authors/:id", "delete", "author", handlers.V2_0AuthorHandler.DeleteAuthor),
+			version.PUT("/authors/:id/profile", "update", "author", handlers.V2_0AuthorHandler.UpdateProfile),
+			version.POST("/authors/:id/verification", "verify", "author", handlers.V2_0AuthorHandler.VerifyAuthor),
+			version.DELETE("/authors/:id/verification", "verify", "author", handlers.V2_0AuthorHandler.UnverifyAuthor),
+			version.GET("/authors/slug/:slug", "get", "author", handlers.V2_0AuthorHandler.GetAuthorBySlug),
+			version.PUT("/authors/:id/slug", "update", "author", handlers.V2_0AuthorHandler.ChangeSlug),
+			version.POST("/authors/:id/merge", "merge", "author", handlers.V2_0AuthorHandler.MergeAuthor),
+			version.GET("/me/authors", "get", "author", handlers.V2_0AuthorHandler.ListMyAuthors),
+			version.POST("/authors/:id/owners", "manage_owners", "author", handlers.V2_0AuthorHandler.AddOwner),
+			version.DELETE("/authors/:id/owners/:userId", "manage_owners", "author", handlers.V2_0AuthorHandler.RemoveOwner),
+			version.POST("/authors/:id/follow", "create", "follow", handlers.V2_0FollowHandler.FollowAuthor),
+			version.DELETE("/authors/:id/follow", "delete", "follow", handlers.V2_0FollowHandler.UnfollowAuthor),
+			version.GET("/feed", "get", "story", handlers.V2_0FeedHandler.GetFeed),
+			version.GET("/me/notifications", "get", "notification", handlers.V2_0NotificationHandler.ListNotifications),
+			version.GET("/me/notifications/unread-count", "get", "notification", handlers.V2_0NotificationHandler.UnreadCount),
+			version.POST("/me/notifications/read-all", "update", "notification", handlers.V2_0NotificationHandler.MarkAllRead),
+			version.POST("/me/notifications/:id/read", "update", "notification", handlers.V2_0NotificationHandler.MarkRead),
+			version.GET("/authors/:id/stats", "view_stats", "author", handlers.V2_0AuthorHandler.GetAuthorStats),
+		},
+	}
+
+	return []version.Version{v1_2, v2_0}
+}
@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	data "go-monolith/internal/bff/data"
+	analyticsdomain "go-monolith/internal/modules/analytics/domain"
+	"go-monolith/pkg/logger"
+	"go-monolith/pkg/metrics"
+)
+
+type AnalyticsService struct {
+	analyticsProvider data.AnalyticsDataProvider
+	Logger            logger.Logger
+	Metrics           *metrics.Client
+}
+
+var analyticsService *AnalyticsService
+
+func NewAnalyticsService(ap data.AnalyticsDataProvider, log logger.Logger, metrics *metrics.Client) *AnalyticsService {
+	if analyticsService == nil {
+		analyticsService = &AnalyticsService{
+			analyticsProvider: ap,
+			Logger:            log,
+			Metrics:           metrics,
+		}
+	}
+	return analyticsService
+}
+
+// GetAnalyticsService returns the singleton instance of AnalyticsService
+func GetAnalyticsService() *AnalyticsService {
+	return analyticsService
+}
+
+// GetAuthorStats retrieves the daily engagement series of an author
+func (s *AnalyticsService) GetAuthorStats(ctx context.Context, authorID, from, to string) (*analyticsdomain.AuthorStats, error) {
+	start := time.Now()
+	stats, err := s.analyticsProvider.GetAuthorStats(ctx, authorID, from, to)
+	if err != nil {
+		s.Logger.Error(ctx, "Failed to fetch author stats",
+			logger.String("author_id", authorID),
+			logger.String("error", err.Error()),
+		)
+		return nil, err
+	}
+
+	s.Metrics.RecordTiming("author.stats.duration", time.Since(start), []string{
+		"author_id:" + authorID,
+	})
+	return stats, nil
+}
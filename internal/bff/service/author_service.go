@@ -0,0 +1,225 @@
+package service
+
+import (
+	"context"
+
+	data "go-monolith/internal/bff/data"
+	authordomain "go-monolith/internal/modules/author/domain"
+	"go-monolith/pkg/logger"
+	"go-monolith/pkg/metrics"
+)
+
+// ProfileLink is a website or social link submitted for an author profile
+type ProfileLink struct {
+	Kind string
+	URL  string
+}
+
+type AuthorService struct {
+	authorProvider data.AuthorDataProvider
+	Logger         logger.Logger
+	Metrics        *metrics.Client
+}
+
+var authorService *AuthorService
+
+func NewAuthorService(ap data.AuthorDataProvider, log logger.Logger, metrics *metrics.Client) *AuthorService {
+	if authorService == nil {
+		authorService = &AuthorService{
+			authorProvider: ap,
+			Logger:         log,
+			Metrics:        metrics,
+		}
+	}
+	return authorService
+}
+
+// GetAuthorService returns the singleton instance of AuthorService
+func GetAuthorService() *AuthorService {
+	return authorService
+}
+
+// ListAuthors returns a page of authors whose name or slug starts with prefix
+func (s *AuthorService) ListAuthors(ctx context.Context, prefix, sort, order, cursor string, limit int) (*authordomain.AuthorPage, error) {
+	page, err := s.authorProvider.ListAuthors(ctx, prefix, sort, order, cursor, limit)
+	if err != nil {
+		s.Logger.Error(ctx, "Failed to list authors",
+			logger.String("error", err.Error()),
+		)
+		return nil, err
+	}
+	return page, nil
+}
+
+// GetAuthorBySlug returns the author with the given slug. Moved reports whether the
+// slug is a previous one, in which case clients should be redirected to author.Slug.
+func (s *AuthorService) GetAuthorBySlug(ctx context.Context, slug string) (author *authordomain.Author, moved bool, err error) {
+	author, err = s.authorProvider.GetAuthorBySlug(ctx, slug)
+	if err != nil {
+		return nil, false, err
+	}
+	return author, author.Slug != slug, nil
+}
+
+// ChangeSlug gives the author a new slug, keeping the previous one resolvable
+func (s *AuthorService) ChangeSlug(ctx context.Context, authorID, slug string) (*authordomain.Author, error) {
+	author, err := s.authorProvider.ChangeAuthorSlug(ctx, authorID, slug)
+	if err != nil {
+		s.Logger.Error(ctx, "Failed to change author slug",
+			logger.String("author_id", authorID),
+			logger.String("error", err.Error()),
+		)
+		return nil, err
+	}
+	return author, nil
+}
+
+// GetAuthor returns the author with the given ID
+func (s *AuthorService) GetAuthor(ctx context.Context, authorID string) (*authordomain.Author, error) {
+	return s.authorProvider.GetAuthor(ctx, authorID)
+}
+
+// CreateAuthor creates an author managed by the current user. The author and its
+// owner are stored together, so an author is never left without one.
+func (s *AuthorService) CreateAuthor(ctx context.Context, firstName, lastName, profileImageURL, slug string) (*authordomain.Author, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	author, err := s.authorProvider.CreateAuthor(ctx, firstName, lastName, profileImageURL, slug, userID)
+	if err != nil {
+		s.Logger.Error(ctx, "Failed to create author",
+			logger.String("slug", slug),
+			logger.String("error", err.Error()),
+		)
+		return nil, err
+	}
+	return author, nil
+}
+
+// UpdateAuthor replaces the name and profile image of an author
+func (s *AuthorService) UpdateAuthor(ctx context.Context, authorID, firstName, lastName, profileImageURL string) (*authordomain.Author, error) {
+	author, err := s.authorProvider.UpdateAuthor(ctx, authorID, firstName, lastName, profileImageURL)
+	if err != nil {
+		s.Logger.Error(ctx, "Failed to update author",
+			logger.String("author_id", authorID),
+			logger.String("error", err.Error()),
+		)
+		return nil, err
+	}
+	return author, nil
+}
+
+// DeleteAuthor removes an author; what happens to its stories depends on the delete policy
+func (s *AuthorService) DeleteAuthor(ctx context.Context, authorID string) error {
+	if err := s.authorProvider.DeleteAuthor(ctx, authorID); err != nil {
+		s.Logger.Error(ctx, "Failed to delete author",
+			logger.String("author_id", authorID),
+			logger.String("error", err.Error()),
+		)
+		return err
+	}
+	return nil
+}
+
+// UpdateProfile replaces the author's bio, location and links
+func (s *AuthorService) UpdateProfile(ctx context.Context, authorID, bio, location string, links []ProfileLink) (*authordomain.Author, error) {
+	domainLinks := make([]authordomain.Link, len(links))
+	for i, link := range links {
+		domainLinks[i] = authordomain.Link{Kind: authordomain.LinkKind(link.Kind), URL: link.URL}
+	}
+
+	author, err := s.authorProvider.UpdateAuthorProfile(ctx, authorID, bio, location, domainLinks)
+	if err != nil {
+		s.Logger.Error(ctx, "Failed to update author profile",
+			logger.String("author_id", authorID),
+			logger.String("error", err.Error()),
+		)
+		return nil, err
+	}
+	return author, nil
+}
+
+// Verify marks the author as verified by the current user
+func (s *AuthorService) Verify(ctx context.Context, authorID string) (*authordomain.Author, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.authorProvider.VerifyAuthor(ctx, authorID, userID)
+}
+
+// Unverify removes the author's verification badge
+func (s *AuthorService) Unverify(ctx context.Context, authorID string) (*authordomain.Author, error) {
+	return s.authorProvider.UnverifyAuthor(ctx, authorID)
+}
+
+// MergeAuthors merges the duplicate source author into the target on behalf of the
+// current user. A dry run only previews what would move.
+func (s *AuthorService) MergeAuthors(ctx context.Context, sourceID, targetID string, dryRun bool) (*authordomain.MergePlan, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := s.authorProvider.MergeAuthors(ctx, sourceID, targetID, userID, dryRun)
+	if err != nil {
+		s.Logger.Error(ctx, "Failed to merge authors",
+			logger.String("source_id", sourceID),
+			logger.String("target_id", targetID),
+			logger.String("error", err.Error()),
+		)
+		return nil, err
+	}
+	return plan, nil
+}
+
+// ListMyAuthors returns the author personas managed by the current user
+func (s *AuthorService) ListMyAuthors(ctx context.Context) ([]*authordomain.Author, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.authorProvider.ListOwnedAuthors(ctx, userID)
+}
+
+// AddOwner lets the user manage the author
+func (s *AuthorService) AddOwner(ctx context.Context, authorID, userID string) error {
+	if err := s.authorProvider.AddAuthorOwner(ctx, authorID, userID); err != nil {
+		s.Logger.Error(ctx, "Failed to add author owner",
+			logger.String("author_id", authorID),
+			logger.String("error", err.Error()),
+		)
+		return err
+	}
+	return nil
+}
+
+// RemoveOwner stops the user from managing the author
+func (s *AuthorService) RemoveOwner(ctx context.Context, authorID, userID string) error {
+	return s.authorProvider.RemoveAuthorOwner(ctx, authorID, userID)
+}
+
+// AttachStats loads the story aggregates of the given authors in one batch
+func (s *AuthorService) AttachStats(ctx context.Context, authors ...*authordomain.Author) error {
+	if len(authors) == 0 {
+		return nil
+	}
+
+	ids := make([]uint, len(authors))
+	for i, author := range authors {
+		ids[i] = author.ID
+	}
+	stats, err := s.authorProvider.GetAuthorAggregates(ctx, ids)
+	if err != nil {
+		s.Logger.Error(ctx, "Failed to get author aggregates",
+			logger.String("error", err.Error()),
+		)
+		return err
+	}
+	for _, author := range authors {
+		author.Stats = stats[author.ID]
+	}
+	return nil
+}
@@ -0,0 +1,240 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"time"
+
+	data "go-monolith/internal/bff/data"
+	authordomain "go-monolith/internal/modules/author/domain"
+	storydomain "go-monolith/internal/modules/story/domain"
+	"go-monolith/pkg/errors"
+	"go-monolith/pkg/logger"
+	"go-monolith/pkg/metrics"
+)
+
+const (
+	// DefaultFeedLimit is the page size used when no limit is given
+	DefaultFeedLimit = 20
+	// MaxFeedLimit caps the feed page size
+	MaxFeedLimit = 50
+)
+
+// FeedSource tells where the stories of a feed page come from
+type FeedSource string
+
+const (
+	FeedSourceFollowing FeedSource = "following"
+	FeedSourceTrending  FeedSource = "trending"
+)
+
+// FeedItem is a story of the feed together with its author
+type FeedItem struct {
+	Story  *storydomain.Story
+	Author *authordomain.Author
+}
+
+// FeedPage is one page of the home feed. NextCursor is empty on the last page.
+type FeedPage struct {
+	Items      []FeedItem
+	Source     FeedSource
+	NextCursor string
+}
+
+type FeedService struct {
+	storyProvider  data.StoryDataProvider
+	authorProvider data.AuthorDataProvider
+	followProvider data.FollowDataProvider
+	Logger         logger.Logger
+	Metrics        *metrics.Client
+}
+
+var feedService *FeedService
+
+func NewFeedService(sp data.StoryDataProvider, ap data.AuthorDataProvider, fp data.FollowDataProvider, log logger.Logger, metrics *metrics.Client) *FeedService {
+	if feedService == nil {
+		feedService = &FeedService{
+			storyProvider:  sp,
+			authorProvider: ap,
+			followProvider: fp,
+			Logger:         log,
+			Metrics:        metrics,
+		}
+	}
+	return feedService
+}
+
+// GetFeedService returns the singleton instance of FeedService
+func GetFeedService() *FeedService {
+	return feedService
+}
+
+// GetFeed returns a page of the current user's home feed: recently published stories
+// of followed authors, newest first. Users who follow nobody get trending stories.
+func (s *FeedService) GetFeed(ctx context.Context, cursor string, limit int) (*FeedPage, error) {
+	start := time.Now()
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if limit <= 0 {
+		limit = DefaultFeedLimit
+	}
+	if limit > MaxFeedLimit {
+		limit = MaxFeedLimit
+	}
+
+	fc, err := decodeFeedCursor(cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	var page *FeedPage
+	switch fc.source {
+	case FeedSourceTrending:
+		page, err = s.trendingPage(ctx, fc.offset, limit)
+	case FeedSourceFollowing:
+		page, err = s.followingPage(ctx, userID, fc.after, limit)
+	default:
+		authorIDs, lerr := s.followProvider.ListFollowedAuthorIDs(ctx, userID)
+		if lerr != nil {
+			return nil, lerr
+		}
+		if len(authorIDs) == 0 {
+			page, err = s.trendingPage(ctx, 0, limit)
+		} else {
+			page, err = s.authorsPage(ctx, authorIDs, nil, limit)
+		}
+	}
+	if err != nil {
+		s.Logger.Error(ctx, "Failed to build feed",
+			logger.String("error", err.Error()),
+		)
+		return nil, err
+	}
+
+	s.Metrics.IncrementCounter("feed.get.success", []string{
+		"source:" + string(page.Source),
+	})
+	s.Metrics.RecordTiming("feed.get.duration", time.Since(start), []string{
+		"source:" + string(page.Source),
+	})
+	return page, nil
+}
+
+func (s *FeedService) followingPage(ctx context.Context, userID string, after *storydomain.FeedCursor, limit int) (*FeedPage, error) {
+	authorIDs, err := s.followProvider.ListFollowedAuthorIDs(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return s.authorsPage(ctx, authorIDs, after, limit)
+}
+
+func (s *FeedService) authorsPage(ctx context.Context, authorIDs []uint, after *storydomain.FeedCursor, limit int) (*FeedPage, error) {
+	stories, err := s.storyProvider.ListFeed(ctx, authorIDs, after, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &FeedPage{Items: s.withAuthors(ctx, stories), Source: FeedSourceFollowing}
+	if len(stories) == limit {
+		if next := storydomain.CursorOf(stories[len(stories)-1]); next != nil {
+			page.NextCursor = encodeFeedCursor(feedCursor{source: FeedSourceFollowing, after: next})
+		}
+	}
+	return page, nil
+}
+
+func (s *FeedService) trendingPage(ctx context.Context, offset, limit int) (*FeedPage, error) {
+	stories, err := s.storyProvider.ListTrending(ctx, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &FeedPage{Items: s.withAuthors(ctx, stories), Source: FeedSourceTrending}
+	if len(stories) == limit {
+		page.NextCursor = encodeFeedCursor(feedCursor{source: FeedSourceTrending, offset: offset + limit})
+	}
+	return page, nil
+}
+
+// withAuthors attaches authors to the stories, fetching each author once.
+// Authors that fail to load are left nil rather than failing the page.
+func (s *FeedService) withAuthors(ctx context.Context, stories []*storydomain.Story) []FeedItem {
+	authors := make(map[uint]*authordomain.Author)
+	items := make([]FeedItem, len(stories))
+	for i, story := range stories {
+		author, ok := authors[story.AuthorID]
+		if !ok {
+			var err error
+			author, err = s.authorProvider.GetAuthor(ctx, strconv.FormatUint(uint64(story.AuthorID), 10))
+			if err != nil {
+				s.Logger.Warn(ctx, "Failed to fetch author for feed story",
+					logger.String("story_id", strconv.FormatUint(uint64(story.ID), 10)),
+					logger.String("error", err.Error()),
+				)
+			}
+			authors[story.AuthorID] = author
+		}
+		items[i] = FeedItem{Story: story, Author: author}
+	}
+	return items
+}
+
+// feedCursor is the decoded form of the opaque cursor handed to clients.
+// Following pages resume after a story, trending pages at an offset.
+type feedCursor struct {
+	source FeedSource
+	after  *storydomain.FeedCursor
+	offset int
+}
+
+func encodeFeedCursor(c feedCursor) string {
+	var raw string
+	switch c.source {
+	case FeedSourceFollowing:
+		raw = "f:" + strconv.FormatInt(c.after.PublishedAt.UnixNano(), 10) + ":" + strconv.FormatUint(uint64(c.after.ID), 10)
+	case FeedSourceTrending:
+		raw = "t:" + strconv.Itoa(c.offset)
+	}
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeFeedCursor(cursor string) (feedCursor, error) {
+	if cursor == "" {
+		return feedCursor{}, nil
+	}
+
+	invalid := errors.NewValidationError("invalid feed cursor")
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return feedCursor{}, invalid
+	}
+
+	parts := strings.Split(string(raw), ":")
+	switch {
+	case len(parts) == 3 && parts[0] == "f":
+		nanos, err := strconv.ParseInt(parts[1], 10, 64)
+		if err != nil {
+			return feedCursor{}, invalid
+		}
+		id, err := strconv.ParseUint(parts[2], 10, 64)
+		if err != nil {
+			return feedCursor{}, invalid
+		}
+		return feedCursor{
+			source: FeedSourceFollowing,
+			after:  &storydomain.FeedCursor{PublishedAt: time.Unix(0, nanos), ID: uint(id)},
+		}, nil
+	case len(parts) == 2 && parts[0] == "t":
+		offset, err := strconv.Atoi(parts[1])
+		if err != nil || offset < 0 {
+			return feedCursor{}, invalid
+		}
+		return feedCursor{source: FeedSourceTrending, offset: offset}, nil
+	}
+	return feedCursor{}, invalid
+}
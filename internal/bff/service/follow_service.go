@@ -0,0 +1,75 @@
+package service
+
+import (
+	"context"
+
+	data "go-monolith/internal/bff/data"
+	"go-monolith/pkg/logger"
+	"go-monolith/pkg/metrics"
+)
+
+type FollowService struct {
+	authorProvider data.AuthorDataProvider
+	followProvider data.FollowDataProvider
+	Logger         logger.Logger
+	Metrics        *metrics.Client
+}
+
+var followService *FollowService
+
+func NewFollowService(ap data.AuthorDataProvider, fp data.FollowDataProvider, log logger.Logger, metrics *metrics.Client) *FollowService {
+	if followService == nil {
+		followService = &FollowService{
+			authorProvider: ap,
+			followProvider: fp,
+			Logger:         log,
+			Metrics:        metrics,
+		}
+	}
+	return followService
+}
+
+// GetFollowService returns the singleton instance of FollowService
+func GetFollowService() *FollowService {
+	return followService
+}
+
+// FollowAuthor makes the current user follow an existing author
+func (s *FollowService) FollowAuthor(ctx context.Context, authorID string) error {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return err
+	}
+
+	if _, err := s.authorProvider.GetAuthor(ctx, authorID); err != nil {
+		return err
+	}
+
+	if err := s.followProvider.Follow(ctx, userID, authorID); err != nil {
+		s.Logger.Error(ctx, "Failed to follow author",
+			logger.String("author_id", authorID),
+			logger.String("error", err.Error()),
+		)
+		return err
+	}
+	return nil
+}
+
+// UnfollowAuthor removes the current user's follow of an author
+func (s *FollowService) UnfollowAuthor(ctx context.Context, authorID string) error {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return err
+	}
+	return s.followProvider.Unfollow(ctx, userID, authorID)
+}
+
+// IsFollowing reports whether the current user follows the author.
+// Anonymous users follow nobody.
+func (s *FollowService) IsFollowing(ctx context.Context, authorID string) (bool, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return false, nil
+	}
+	return s.followProvider.IsFollowing(ctx, userID, authorID)
+}
@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"io"
+	"time"
+
+	data "go-monolith/internal/bff/data"
+	mediadomain "go-monolith/internal/modules/media/domain"
+	"go-monolith/pkg/errors"
+	"go-monolith/pkg/logger"
+	"go-monolith/pkg/metrics"
+)
+
+type MediaService struct {
+	storyProvider data.StoryDataProvider
+	mediaProvider data.MediaDataProvider
+	Logger        logger.Logger
+	Metrics       *metrics.Client
+}
+
+var mediaService *MediaService
+
+func NewMediaService(sp data.StoryDataProvider, mp data.MediaDataProvider, log logger.Logger, metrics *metrics.Client) *MediaService {
+	if mediaService == nil {
+		mediaService = &MediaService{
+			storyProvider: sp,
+			mediaProvider: mp,
+			Logger:        log,
+			Metrics:       metrics,
+		}
+	}
+	return mediaService
+}
+
+// GetMediaService returns the singleton instance of MediaService
+func GetMediaService() *MediaService {
+	return mediaService
+}
+
+// UploadStoryImage attaches a cover or inline image to an existing story
+func (s *MediaService) UploadStoryImage(ctx context.Context, storyID, kind string, r io.Reader) (*mediadomain.Media, error) {
+	start := time.Now()
+
+	// Make sure the story exists before storing anything for it
+	if _, err := s.storyProvider.GetStory(ctx, storyID); err != nil {
+		s.Logger.Error(ctx, "Failed to fetch story for media upload",
+			logger.String("story_id", storyID),
+			logger.String("error", err.Error()),
+		)
+		return nil, err
+	}
+
+	media, err := s.mediaProvider.UploadStoryMedia(ctx, storyID, kind, r)
+	if err != nil {
+		return nil, err
+	}
+
+	s.Metrics.RecordTiming("story.media.upload.duration", time.Since(start), []string{
+		"kind:" + kind,
+	})
+	return media, nil
+}
+
+// GetStoryCoverImage returns the cover image of a story, or nil if it has none
+func (s *MediaService) GetStoryCoverImage(ctx context.Context, storyID string) (*mediadomain.Media, error) {
+	media, err := s.mediaProvider.GetCoverImage(ctx, storyID)
+	if err != nil {
+		if kind, ok := errors.KindOf(err); ok && kind == errors.ErrKindNotFound {
+			return nil, nil
+		}
+		s.Logger.Error(ctx, "Failed to fetch story cover image",
+			logger.String("story_id", storyID),
+			logger.String("error", err.Error()),
+		)
+		return nil, err
+	}
+	return media, nil
+}
@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+
+	data "go-monolith/internal/bff/data"
+	notificationdomain "go-monolith/internal/modules/notification/domain"
+	"go-monolith/pkg/logger"
+	"go-monolith/pkg/metrics"
+)
+
+type NotificationService struct {
+	notificationProvider data.NotificationDataProvider
+	Logger               logger.Logger
+	Metrics              *metrics.Client
+}
+
+var notificationService *NotificationService
+
+func NewNotificationService(np data.NotificationDataProvider, log logger.Logger, metrics *metrics.Client) *NotificationService {
+	if notificationService == nil {
+		notificationService = &NotificationService{
+			notificationProvider: np,
+			Logger:               log,
+			Metrics:              metrics,
+		}
+	}
+	return notificationService
+}
+
+// GetNotificationService returns the singleton instance of NotificationService
+func GetNotificationService() *NotificationService {
+	return notificationService
+}
+
+// ListNotifications returns a page of the current user's notifications
+func (s *NotificationService) ListNotifications(ctx context.Context, unreadOnly bool, limit, offset int) ([]*notificationdomain.Notification, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.notificationProvider.ListNotifications(ctx, userID, unreadOnly, limit, offset)
+}
+
+// UnreadCount returns the number of unread notifications of the current user
+func (s *NotificationService) UnreadCount(ctx context.Context) (int64, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return s.notificationProvider.UnreadCount(ctx, userID)
+}
+
+// MarkRead marks one of the current user's notifications as read
+func (s *NotificationService) MarkRead(ctx context.Context, notificationID string) error {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return err
+	}
+	return s.notificationProvider.MarkRead(ctx, userID, notificationID)
+}
+
+// MarkAllRead marks all of the current user's notifications as read
+func (s *NotificationService) MarkAllRead(ctx context.Context) (int64, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return s.notificationProvider.MarkAllRead(ctx, userID)
+}
@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"strconv"
+
+	data "go-monolith/internal/bff/data"
+	authordomain "go-monolith/internal/modules/author/domain"
+	progressdomain "go-monolith/internal/modules/progress/domain"
+	storydomain "go-monolith/internal/modules/story/domain"
+	appctx "go-monolith/pkg/context"
+	"go-monolith/pkg/errors"
+	"go-monolith/pkg/logger"
+	"go-monolith/pkg/metrics"
+)
+
+// ContinueReadingItem is a story the user has started, with its author and reading position
+type ContinueReadingItem struct {
+	Story    *storydomain.Story
+	Author   *authordomain.Author
+	Progress *progressdomain.ReadingProgress
+}
+
+type ProgressService struct {
+	storyProvider    data.StoryDataProvider
+	authorProvider   data.AuthorDataProvider
+	progressProvider data.ProgressDataProvider
+	Logger           logger.Logger
+	Metrics          *metrics.Client
+}
+
+var progressService *ProgressService
+
+func NewProgressService(sp data.StoryDataProvider, ap data.AuthorDataProvider, pp data.ProgressDataProvider, log logger.Logger, metrics *metrics.Client) *ProgressService {
+	if progressService == nil {
+		progressService = &ProgressService{
+			storyProvider:    sp,
+			authorProvider:   ap,
+			progressProvider: pp,
+			Logger:           log,
+			Metrics:          metrics,
+		}
+	}
+	return progressService
+}
+
+// GetProgressService returns the singleton instance of ProgressService
+func GetProgressService() *ProgressService {
+	return progressService
+}
+
+// SaveProgress records the current user's reading position in a story they may read
+func (s *ProgressService) SaveProgress(ctx context.Context, storyID string, percent *float64, offset *int64) (*progressdomain.ReadingProgress, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := GetVisibleStory(ctx, s.storyProvider, s.authorProvider, storyID); err != nil {
+		return nil, err
+	}
+
+	progress, err := s.progressProvider.SaveProgress(ctx, userID, storyID, percent, offset)
+	if err != nil {
+		s.Logger.Error(ctx, "Failed to save reading progress",
+			logger.String("story_id", storyID),
+			logger.String("error", err.Error()),
+		)
+		return nil, err
+	}
+	return progress, nil
+}
+
+// GetStoryProgress returns the current user's reading position in a story,
+// or nil if the user has not started it or is anonymous
+func (s *ProgressService) GetStoryProgress(ctx context.Context, storyID string) (*progressdomain.ReadingProgress, error) {
+	userID := appctx.FromContext(ctx).UserID()
+	if userID == "" {
+		return nil, nil
+	}
+
+	progress, err := s.progressProvider.GetProgress(ctx, userID, storyID)
+	if err != nil {
+		if kind, ok := errors.KindOf(err); ok && kind == errors.ErrKindNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return progress, nil
+}
+
+// ContinueReading returns the stories the current user started but has not finished
+func (s *ProgressService) ContinueReading(ctx context.Context, limit int) ([]ContinueReadingItem, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := s.progressProvider.ListContinueReading(ctx, userID, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]ContinueReadingItem, 0, len(entries))
+	for _, progress := range entries {
+		storyID := strconv.FormatUint(uint64(progress.StoryID), 10)
+		story, err := s.storyProvider.GetStory(ctx, storyID)
+		if err != nil {
+			// Stories deleted since they were read are skipped rather than failing the list
+			s.Logger.Warn(ctx, "Skipping continue reading entry",
+				logger.String("story_id", storyID),
+				logger.String("error", err.Error()),
+			)
+			continue
+		}
+
+		author, err := s.authorProvider.GetAuthor(ctx, strconv.FormatUint(uint64(story.AuthorID), 10))
+		if err != nil {
+			s.Logger.Warn(ctx, "Failed to fetch author for continue reading entry",
+				logger.String("story_id", storyID),
+				logger.String("error", err.Error()),
+			)
+		}
+
+		items = append(items, ContinueReadingItem{
+			Story:    story,
+			Author:   author,
+			Progress: progress,
+		})
+	}
+
+	s.Metrics.IncrementCounter("progress.continue_reading.success", []string{
+		"count:" + strconv.Itoa(len(items)),
+	})
+	return items, nil
+}
+
+// currentUserID returns the authenticated user of the request
+func currentUserID(ctx context.Context) (string, error) {
+	userID := appctx.FromContext(ctx).UserID()
+	if userID == "" {
+		return "", errors.NewUnauthorizedError("user not authenticated")
+	}
+	return userID, nil
+}
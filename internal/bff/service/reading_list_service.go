@@ -0,0 +1,195 @@
+package service
+
+import (
+	"context"
+
+	data "go-monolith/internal/bff/data"
+	readinglistdomain "go-monolith/internal/modules/readinglist/domain"
+	storydomain "go-monolith/internal/modules/story/domain"
+	appctx "go-monolith/pkg/context"
+	"go-monolith/pkg/logger"
+	"go-monolith/pkg/metrics"
+)
+
+// ReadingListDetails is a reading list with its stories in list order
+type ReadingListDetails struct {
+	List    *readinglistdomain.ReadingList
+	Stories []*storydomain.Story
+}
+
+type ReadingListService struct {
+	storyProvider       data.StoryDataProvider
+	authorProvider      data.AuthorDataProvider
+	readingListProvider data.ReadingListDataProvider
+	Logger              logger.Logger
+	Metrics             *metrics.Client
+}
+
+var readingListService *ReadingListService
+
+func NewReadingListService(sp data.StoryDataProvider, ap data.AuthorDataProvider, rp data.ReadingListDataProvider, log logger.Logger, metrics *metrics.Client) *ReadingListService {
+	if readingListService == nil {
+		readingListService = &ReadingListService{
+			storyProvider:       sp,
+			authorProvider:      ap,
+			readingListProvider: rp,
+			Logger:              log,
+			Metrics:             metrics,
+		}
+	}
+	return readingListService
+}
+
+// GetReadingListService returns the singleton instance of ReadingListService
+func GetReadingListService() *ReadingListService {
+	return readingListService
+}
+
+// ListMyLists returns the current user's reading lists
+func (s *ReadingListService) ListMyLists(ctx context.Context) ([]*readinglistdomain.ReadingList, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.readingListProvider.ListUserLists(ctx, userID)
+}
+
+// GetList returns a reading list visible to the current user along with its stories.
+// Stories that no longer exist, and drafts the current user may not read, are left out.
+func (s *ReadingListService) GetList(ctx context.Context, listID string) (*ReadingListDetails, error) {
+	viewerID := appctx.FromContext(ctx).UserID()
+	list, items, err := s.readingListProvider.GetList(ctx, viewerID, listID)
+	if err != nil {
+		return nil, err
+	}
+
+	storyIDs := make([]uint, len(items))
+	for i, item := range items {
+		storyIDs[i] = item.StoryID
+	}
+	stories, err := s.storyProvider.GetStories(ctx, storyIDs)
+	if err != nil {
+		s.Logger.Error(ctx, "Failed to get reading list stories",
+			logger.String("list_id", listID),
+			logger.String("error", err.Error()),
+		)
+		return nil, err
+	}
+	byID := make(map[uint]*storydomain.Story, len(stories))
+	visibility := NewStoryVisibility(s.authorProvider)
+	for _, story := range stories {
+		visible, err := visibility.CanView(ctx, story)
+		if err != nil {
+			return nil, err
+		}
+		if visible {
+			byID[story.ID] = story
+		}
+	}
+
+	// Stories are returned in list order, whatever order they were loaded in
+	details := &ReadingListDetails{
+		List:    list,
+		Stories: make([]*storydomain.Story, 0, len(items)),
+	}
+	for _, item := range items {
+		if story, ok := byID[item.StoryID]; ok {
+			details.Stories = append(details.Stories, story)
+		}
+	}
+	if skipped := len(items) - len(details.Stories); skipped > 0 {
+		s.Logger.Warn(ctx, "Skipping reading list entries of missing or hidden stories",
+			logger.String("list_id", listID),
+			logger.Int("skipped", skipped),
+		)
+	}
+	return details, nil
+}
+
+// CreateList creates a named reading list for the current user
+func (s *ReadingListService) CreateList(ctx context.Context, name, visibility string) (*readinglistdomain.ReadingList, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.readingListProvider.CreateList(ctx, userID, name, visibility)
+}
+
+// UpdateList renames or changes the visibility of one of the current user's lists
+func (s *ReadingListService) UpdateList(ctx context.Context, listID, name, visibility string) (*readinglistdomain.ReadingList, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.readingListProvider.UpdateList(ctx, userID, listID, name, visibility)
+}
+
+// DeleteList deletes one of the current user's lists
+func (s *ReadingListService) DeleteList(ctx context.Context, listID string) error {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return err
+	}
+	return s.readingListProvider.DeleteList(ctx, userID, listID)
+}
+
+// AddStory adds a story the current user may read to one of the current user's lists
+func (s *ReadingListService) AddStory(ctx context.Context, listID, storyID string) (*readinglistdomain.ReadingListItem, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := GetVisibleStory(ctx, s.storyProvider, s.authorProvider, storyID); err != nil {
+		return nil, err
+	}
+	return s.readingListProvider.AddStory(ctx, userID, listID, storyID)
+}
+
+// RemoveStory removes a story from one of the current user's lists
+func (s *ReadingListService) RemoveStory(ctx context.Context, listID, storyID string) error {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return err
+	}
+	return s.readingListProvider.RemoveStory(ctx, userID, listID, storyID)
+}
+
+// Reorder sets the order of the stories in one of the current user's lists
+func (s *ReadingListService) Reorder(ctx context.Context, listID string, storyIDs []string) error {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return err
+	}
+	return s.readingListProvider.Reorder(ctx, userID, listID, storyIDs)
+}
+
+// SaveStory adds a story the current user may read to the current user's default list
+func (s *ReadingListService) SaveStory(ctx context.Context, storyID string) (*readinglistdomain.ReadingListItem, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := GetVisibleStory(ctx, s.storyProvider, s.authorProvider, storyID); err != nil {
+		return nil, err
+	}
+	return s.readingListProvider.SaveStory(ctx, userID, storyID)
+}
+
+// UnsaveStory removes a story from the current user's default list
+func (s *ReadingListService) UnsaveStory(ctx context.Context, storyID string) error {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return err
+	}
+	return s.readingListProvider.UnsaveStory(ctx, userID, storyID)
+}
+
+// IsStorySaved reports whether the current user has the story in any list.
+// Anonymous users have nothing saved.
+func (s *ReadingListService) IsStorySaved(ctx context.Context, storyID string) (bool, error) {
+	userID := appctx.FromContext(ctx).UserID()
+	if userID == "" {
+		return false, nil
+	}
+	return s.readingListProvider.IsSaved(ctx, userID, storyID)
+}
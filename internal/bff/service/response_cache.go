@@ -0,0 +1,233 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"hash/fnv"
+	"strconv"
+	"time"
+
+	authordomain "go-monolith/internal/modules/author/domain"
+	storydomain "go-monolith/internal/modules/story/domain"
+	"go-monolith/pkg/cache"
+	"go-monolith/pkg/events"
+	"go-monolith/pkg/logger"
+	"go-monolith/pkg/metrics"
+)
+
+// ResponseCache caches the responses built from story displays, so repeated views
+// of a story skip the story and author lookups.
+//
+// Entries are keyed by API version, selected fields and the story's generation. A
+// generation is a token stored in the cache per story and per author; invalidating
+// replaces it, which leaves every entry built from the old one unreachable. Entries
+// also record their author's generation and are discarded once it changes. A
+// generation missing from the cache is replaced too, so an evicted generation can
+// only cause misses, never stale hits.
+type ResponseCache struct {
+	cache   cache.Cache
+	ttl     time.Duration
+	Logger  logger.Logger
+	Metrics *metrics.Client
+}
+
+var responseCache *ResponseCache
+
+func NewResponseCache(c cache.Cache, ttl time.Duration, log logger.Logger, metrics *metrics.Client) *ResponseCache {
+	if responseCache == nil {
+		responseCache = &ResponseCache{
+			cache:   c,
+			ttl:     ttl,
+			Logger:  log,
+			Metrics: metrics,
+		}
+	}
+	return responseCache
+}
+
+// GetResponseCache returns the singleton instance of ResponseCache
+func GetResponseCache() *ResponseCache {
+	return responseCache
+}
+
+// cachedResponse is the stored form of a response together with the generation of
+// the author it was built from
+type cachedResponse struct {
+	AuthorID     uint            `json:"authorId"`
+	AuthorGen    string          `json:"authorGen"`
+	LastModified time.Time       `json:"lastModified"`
+	Body         json.RawMessage `json:"body"`
+}
+
+// DisplayResult is a response built from a story display. LastModified is when the
+// story or its author last changed; Warnings lists the sections that failed to load.
+type DisplayResult[T any] struct {
+	Response     T
+	LastModified time.Time
+	Warnings     []SectionWarning
+}
+
+// CachedStoryResponse returns the response build makes from the story display,
+// reusing one built for the same API version and fields while neither the story nor
+// its author has changed. Views are recorded on hits as well, from the story and
+// author IDs of the entry alone. Partial responses are
+// not cached, so a degraded section is retried on the next request, and neither are
+// drafts, whose readers are checked on every request.
+func CachedStoryResponse[T any](ctx context.Context, s *StoryService, version, fields, storyID string, build func(*StoryDisplay) T) (*DisplayResult[T], error) {
+	c := responseCache
+
+	// IDs are keyed in canonical form so invalidation reaches every entry of a story
+	var key string
+	if id, err := strconv.ParseUint(storyID, 10, 64); c != nil && err == nil {
+		key = c.storyKey(ctx, version, fields, strconv.FormatUint(id, 10))
+		if entry, ok := c.lookup(ctx, key, version); ok {
+			result := &DisplayResult[T]{LastModified: entry.LastModified}
+			if err := json.Unmarshal(entry.Body, &result.Response); err == nil {
+				s.recordView(ctx, uint(id), entry.AuthorID)
+				return result, nil
+			}
+		}
+	}
+
+	display, err := s.GetStoryDisplayDetails(ctx, storyID)
+	if err != nil {
+		return nil, err
+	}
+	result := &DisplayResult[T]{
+		Response:     build(display),
+		LastModified: display.LastModified(),
+		Warnings:     display.Warnings,
+	}
+	if c != nil && key != "" && len(display.Warnings) == 0 && display.Story.PublishedAt != nil {
+		c.store(ctx, key, display.Story.AuthorID, result.LastModified, result.Response)
+	}
+	return result, nil
+}
+
+// HandleStoryChanged invalidates the responses of an updated, published or deleted story
+func (c *ResponseCache) HandleStoryChanged(ctx context.Context, event events.Event) {
+	var storyID uint
+	switch changed := event.(type) {
+	case storydomain.StoryUpdated:
+		storyID = changed.StoryID
+	case storydomain.StoryPublished:
+		storyID = changed.StoryID
+	case storydomain.StoryDeleted:
+		storyID = changed.StoryID
+	default:
+		return
+	}
+	c.invalidate(ctx, storyGenKey(strconv.FormatUint(uint64(storyID), 10)))
+}
+
+// HandleAuthorChanged invalidates the responses built with an updated, deleted or
+// merged author. Stories moved to another author are invalidated with it.
+func (c *ResponseCache) HandleAuthorChanged(ctx context.Context, event events.Event) {
+	var authorID uint
+	switch changed := event.(type) {
+	case authordomain.AuthorUpdated:
+		authorID = changed.AuthorID
+	case authordomain.AuthorDeleted:
+		authorID = changed.AuthorID
+	case authordomain.AuthorsMerged:
+		authorID = changed.SourceID
+	default:
+		return
+	}
+	c.invalidate(ctx, authorGenKey(authorID))
+}
+
+func (c *ResponseCache) storyKey(ctx context.Context, version, fields, storyID string) string {
+	gen, ok := c.generation(ctx, storyGenKey(storyID))
+	if !ok {
+		return ""
+	}
+	hash := fnv.New64a()
+	hash.Write([]byte(fields))
+	return "bff:response:" + version + ":story:" + storyID + ":" + gen + ":" + strconv.FormatUint(hash.Sum64(), 36)
+}
+
+// lookup returns the entry cached under key if its author is unchanged
+func (c *ResponseCache) lookup(ctx context.Context, key, version string) (*cachedResponse, bool) {
+	tags := []string{"cache:bff_response", "resource:story", "version:" + version}
+	if key == "" {
+		c.Metrics.IncrementCacheMisses(tags)
+		return nil, false
+	}
+
+	value, found, err := c.cache.Get(ctx, key)
+	if err != nil {
+		c.Logger.Warn(ctx, "Failed to read response cache",
+			logger.String("key", key),
+			logger.String("error", err.Error()),
+		)
+	}
+	var entry cachedResponse
+	if found && err == nil && json.Unmarshal(value, &entry) == nil {
+		if gen, ok := c.generation(ctx, authorGenKey(entry.AuthorID)); ok && gen == entry.AuthorGen {
+			c.Metrics.IncrementCacheHits(tags)
+			return &entry, true
+		}
+	}
+	c.Metrics.IncrementCacheMisses(tags)
+	return nil, false
+}
+
+func (c *ResponseCache) store(ctx context.Context, key string, authorID uint, lastModified time.Time, resp interface{}) {
+	gen, ok := c.generation(ctx, authorGenKey(authorID))
+	if !ok {
+		return
+	}
+	body, err := json.Marshal(resp)
+	if err != nil {
+		return
+	}
+	value, err := json.Marshal(cachedResponse{AuthorID: authorID, AuthorGen: gen, LastModified: lastModified, Body: body})
+	if err != nil {
+		return
+	}
+	if err := c.cache.Set(ctx, key, value, c.ttl); err != nil {
+		c.Logger.Warn(ctx, "Failed to write response cache",
+			logger.String("key", key),
+			logger.String("error", err.Error()),
+		)
+	}
+}
+
+// generation returns the current generation stored under genKey, starting a new one
+// when there is none. ok is false when the cache could not be used.
+func (c *ResponseCache) generation(ctx context.Context, genKey string) (string, bool) {
+	value, found, err := c.cache.Get(ctx, genKey)
+	if err != nil {
+		c.Logger.Warn(ctx, "Failed to read cache generation",
+			logger.String("key", genKey),
+			logger.String("error", err.Error()),
+		)
+		return "", false
+	}
+	if found {
+		return string(value), true
+	}
+	return c.invalidate(ctx, genKey)
+}
+
+// invalidate starts a new generation under genKey and returns it
+func (c *ResponseCache) invalidate(ctx context.Context, genKey string) (string, bool) {
+	gen := strconv.FormatInt(time.Now().UnixNano(), 36)
+	if err := c.cache.Set(ctx, genKey, []byte(gen), 0); err != nil {
+		c.Logger.Warn(ctx, "Failed to write cache generation",
+			logger.String("key", genKey),
+			logger.String("error", err.Error()),
+		)
+		return "", false
+	}
+	return gen, true
+}
+
+func storyGenKey(storyID string) string {
+	return "bff:gen:story:" + storyID
+}
+
+func authorGenKey(authorID uint) string {
+	return "bff:gen:author:" + strconv.FormatUint(uint64(authorID), 10)
+}
@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"go-monolith/pkg/logger"
+	"go-monolith/pkg/metrics"
+)
+
+// Reasons an optional section is missing from a response
+const (
+	SectionReasonError   = "error"
+	SectionReasonTimeout = "timeout"
+)
+
+// defaultSectionTimeout applies until NewSectionLoader configures one
+const defaultSectionTimeout = time.Second
+
+// SectionWarning reports an optional section of a response that could not be loaded.
+// Section is the name of the response field the section fills.
+type SectionWarning struct {
+	Section string
+	Reason  string
+}
+
+// SectionLoader bounds the time optional response sections may take, so a slow or
+// failing dependency degrades the response instead of failing it
+type SectionLoader struct {
+	timeout time.Duration
+	Logger  logger.Logger
+	Metrics *metrics.Client
+}
+
+var sectionLoader *SectionLoader
+
+func NewSectionLoader(timeout time.Duration, log logger.Logger, metrics *metrics.Client) *SectionLoader {
+	if sectionLoader == nil {
+		sectionLoader = &SectionLoader{
+			timeout: timeout,
+			Logger:  log,
+			Metrics: metrics,
+		}
+	}
+	return sectionLoader
+}
+
+// GetSectionLoader returns the singleton instance of SectionLoader
+func GetSectionLoader() *SectionLoader {
+	return sectionLoader
+}
+
+// LoadSection loads an optional section within the section timeout. When load fails
+// or times out the zero value is returned together with a warning naming the section.
+func LoadSection[T any](ctx context.Context, section string, load func(ctx context.Context) (T, error)) (T, *SectionWarning) {
+	timeout := defaultSectionTimeout
+	if sectionLoader != nil && sectionLoader.timeout > 0 {
+		timeout = sectionLoader.timeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	type result struct {
+		value T
+		err   error
+	}
+	// Buffered so a load that outlives the timeout can still finish and be collected
+	done := make(chan result, 1)
+	go func() {
+		value, err := load(ctx)
+		done <- result{value: value, err: err}
+	}()
+
+	var zero T
+	select {
+	case r := <-done:
+		if r.err != nil {
+			sectionLoader.degraded(ctx, section, SectionReasonError, r.err)
+			return zero, &SectionWarning{Section: section, Reason: SectionReasonError}
+		}
+		return r.value, nil
+	case <-ctx.Done():
+		sectionLoader.degraded(ctx, section, SectionReasonTimeout, ctx.Err())
+		return zero, &SectionWarning{Section: section, Reason: SectionReasonTimeout}
+	}
+}
+
+// degraded records a section left out of a response
+func (l *SectionLoader) degraded(ctx context.Context, section, reason string, err error) {
+	if l == nil {
+		return
+	}
+	l.Logger.Warn(ctx, "Optional section left out of response",
+		logger.String("section", section),
+		logger.String("reason", reason),
+		logger.String("error", err.Error()),
+	)
+	l.Metrics.IncrementCounter("bff.section.degraded", []string{
+		"section:" + section,
+		"reason:" + reason,
+	})
+}
@@ -3,31 +3,50 @@ package service
 import (
 	"context"
 	"strconv"
+	"strings"
 	"time"
 
 	data "go-monolith/internal/bff/data"
 	authordomain "go-monolith/internal/modules/author/domain"
 	storydomain "go-monolith/internal/modules/story/domain"
+	appctx "go-monolith/pkg/context"
+	"go-monolith/pkg/errors"
 	"go-monolith/pkg/logger"
 	"go-monolith/pkg/metrics"
 )
 
+// MaxBatchStories bounds how many stories one batch request may ask for
+const MaxBatchStories = 50
+
+// StoryDisplayItem is one story of a batch together with its author. Err is set
+// instead of Story when that story could not be returned; when the author could
+// not be loaded Author is nil and Warnings names the author section.
+type StoryDisplayItem struct {
+	ID       string
+	Story    *storydomain.Story
+	Author   *authordomain.Author
+	Warnings []SectionWarning
+	Err      error
+}
+
 type StoryService struct {
-	storyProvider  data.StoryDataProvider
-	authorProvider data.AuthorDataProvider
-	Logger         logger.Logger
-	Metrics        *metrics.Client
+	storyProvider     data.StoryDataProvider
+	authorProvider    data.AuthorDataProvider
+	analyticsProvider data.AnalyticsDataProvider
+	Logger            logger.Logger
+	Metrics           *metrics.Client
 }
 
 var storyService *StoryService
 
-func NewStoryService(sp data.StoryDataProvider, ap data.AuthorDataProvider, log logger.Logger, metrics *metrics.Client) *StoryService {
+func NewStoryService(sp data.StoryDataProvider, ap data.AuthorDataProvider, anp data.AnalyticsDataProvider, log logger.Logger, metrics *metrics.Client) *StoryService {
 	if storyService == nil {
 		storyService = &StoryService{
-			storyProvider:  sp,
-			authorProvider: ap,
-			Logger:         log,
-			Metrics:        metrics,
+			storyProvider:     sp,
+			authorProvider:    ap,
+			analyticsProvider: anp,
+			Logger:            log,
+			Metrics:           metrics,
 		}
 	}
 	return storyService
@@ -38,9 +57,28 @@ func GetStoryService() *StoryService {
 	return storyService
 }
 
+// StoryDisplay is a story with its author. When the author could not be loaded
+// Author is nil and Warnings names the author section.
+type StoryDisplay struct {
+	Story    *storydomain.Story
+	Author   *authordomain.Author
+	Warnings []SectionWarning
+}
+
+// LastModified returns when the story or its author last changed
+func (d *StoryDisplay) LastModified() time.Time {
+	lastModified := d.Story.UpdatedAt
+	if d.Author != nil && d.Author.UpdatedAt.After(lastModified) {
+		lastModified = d.Author.UpdatedAt
+	}
+	return lastModified
+}
+
 // GetStoryDisplayDetails retrieves a story and its author details
-// Used by both v1.2 and v2.0, but v2.0 formats the response differently in its handler
-func (s *StoryService) GetStoryDisplayDetails(ctx context.Context, storyID string) (*storydomain.Story, *authordomain.Author, error) {
+// Used by both v1.2 and v2.0, but v2.0 formats the response differently in its handler.
+// Only a failure to load the story is an error; the author is an optional section.
+// Drafts are only shown to the owners of their author and do not count views.
+func (s *StoryService) GetStoryDisplayDetails(ctx context.Context, storyID string) (*StoryDisplay, error) {
 	start := time.Now()
 	s.Logger.Info(ctx, "Fetching story details",
 		logger.String("story_id", storyID),
@@ -51,7 +89,7 @@ func (s *StoryService) GetStoryDisplayDetails(ctx context.Context, storyID strin
 		"story_id:" + storyID,
 	})
 
-	story, err := s.storyProvider.GetStory(ctx, storyID)
+	story, err := GetVisibleStory(ctx, s.storyProvider, s.authorProvider, storyID)
 	if err != nil {
 		s.Logger.Error(ctx, "Failed to fetch story",
 			logger.String("story_id", storyID),
@@ -62,8 +100,9 @@ func (s *StoryService) GetStoryDisplayDetails(ctx context.Context, storyID strin
 			"story_id:" + storyID,
 			"error_type:story_fetch",
 		})
-		return nil, nil, err
+		return nil, err
 	}
+	display := &StoryDisplay{Story: story}
 
 	authorID := strconv.FormatUint(uint64(story.AuthorID), 10)
 	// Record author fetch attempt
@@ -72,35 +111,39 @@ func (s *StoryService) GetStoryDisplayDetails(ctx context.Context, storyID strin
 		"author_id:" + authorID,
 	})
 
-	author, err := s.authorProvider.GetAuthor(ctx, authorID)
-	if err != nil {
-		s.Logger.Error(ctx, "Failed to fetch author",
-			logger.String("story_id", storyID),
-			logger.String("author_id", authorID),
-			logger.String("error", err.Error()),
-		)
+	author, warning := LoadSection(ctx, "author", func(ctx context.Context) (*authordomain.Author, error) {
+		return s.authorProvider.GetAuthor(ctx, authorID)
+	})
+	if warning != nil {
 		// Record author fetch error
 		s.Metrics.IncrementCounter("author.fetch.error", []string{
 			"story_id:" + storyID,
 			"author_id:" + authorID,
-			"error_type:author_fetch",
+			"error_type:author_" + warning.Reason,
+		})
+		display.Warnings = append(display.Warnings, *warning)
+	} else {
+		display.Author = author
+		s.Metrics.IncrementCounter("author.fetch.success", []string{
+			"story_id:" + storyID,
+			"author_id:" + authorID,
 		})
-		return story, nil, err
 	}
 
-	s.Logger.Info(ctx, "Successfully fetched story and author details",
+	if story.PublishedAt != nil {
+		s.recordView(ctx, story.ID, story.AuthorID)
+	}
+
+	s.Logger.Info(ctx, "Fetched story details",
 		logger.String("story_id", storyID),
 		logger.String("author_id", authorID),
+		logger.Bool("partial", len(display.Warnings) > 0),
 	)
 
-	// Record successful story and author fetch
+	// Record successful story fetch
 	s.Metrics.IncrementCounter("story.fetch.success", []string{
 		"story_id:" + storyID,
 	})
-	s.Metrics.IncrementCounter("author.fetch.success", []string{
-		"story_id:" + storyID,
-		"author_id:" + authorID,
-	})
 
 	// Record total operation duration
 	duration := time.Since(start)
@@ -108,5 +151,216 @@ func (s *StoryService) GetStoryDisplayDetails(ctx context.Context, storyID strin
 		"story_id:" + storyID,
 	})
 
-	return story, author, nil
+	return display, nil
+}
+
+// GetStoriesDisplayDetails retrieves several stories and their authors with one
+// lookup for the stories and one for their distinct authors. Items follow the order
+// of storyIDs; a missing or invalid ID fails its own item, not the batch, and so does
+// a draft the current user may not read. Unlike GetStoryDisplayDetails it does not
+// count views, as batches back lists of stories.
+func (s *StoryService) GetStoriesDisplayDetails(ctx context.Context, storyIDs []string) ([]StoryDisplayItem, error) {
+	start := time.Now()
+	if len(storyIDs) == 0 {
+		return nil, errors.NewValidationError("at least one story ID is required")
+	}
+	if len(storyIDs) > MaxBatchStories {
+		return nil, errors.NewValidationError("at most " + strconv.Itoa(MaxBatchStories) + " story IDs may be requested")
+	}
+
+	items := make([]StoryDisplayItem, len(storyIDs))
+	ids := make([]uint, 0, len(storyIDs))
+	for i, storyID := range storyIDs {
+		storyID = strings.TrimSpace(storyID)
+		items[i].ID = storyID
+		id, err := strconv.ParseUint(storyID, 10, 64)
+		if err != nil || id == 0 {
+			items[i].Err = errors.NewValidationError("invalid story ID")
+			continue
+		}
+		ids = append(ids, uint(id))
+	}
+
+	stories, err := s.storyProvider.GetStories(ctx, ids)
+	if err != nil {
+		s.Logger.Error(ctx, "Failed to fetch stories",
+			logger.Int("stories", len(ids)),
+			logger.String("error", err.Error()),
+		)
+		s.Metrics.IncrementCounter("story.fetch.error", []string{
+			"error_type:story_fetch",
+			"type:batch",
+		})
+		return nil, err
+	}
+	storiesByID := make(map[uint]*storydomain.Story, len(stories))
+	authorIDs := make([]uint, 0, len(stories))
+	for _, story := range stories {
+		storiesByID[story.ID] = story
+		authorIDs = append(authorIDs, story.AuthorID)
+	}
+
+	// A failed author lookup leaves the authors out with a warning rather than failing the batch
+	authorsByID := make(map[uint]*authordomain.Author)
+	authors, err := s.authorProvider.GetAuthors(ctx, uniqueIDs(authorIDs))
+	if err != nil {
+		s.Logger.Warn(ctx, "Failed to fetch authors for stories",
+			logger.Int("authors", len(authorIDs)),
+			logger.String("error", err.Error()),
+		)
+		s.Metrics.IncrementCounter("author.fetch.error", []string{
+			"error_type:author_fetch",
+			"type:batch",
+		})
+	}
+	for _, author := range authors {
+		authorsByID[author.ID] = author
+	}
+
+	missing := 0
+	visibility := NewStoryVisibility(s.authorProvider)
+	for i := range items {
+		if items[i].Err != nil {
+			continue
+		}
+		id, _ := strconv.ParseUint(items[i].ID, 10, 64)
+		story, ok := storiesByID[uint(id)]
+		if ok {
+			visible, err := visibility.CanView(ctx, story)
+			if err != nil {
+				items[i].Err = err
+				continue
+			}
+			ok = visible
+		}
+		if !ok {
+			items[i].Err = errors.NewNotFoundError("story", items[i].ID)
+			missing++
+			continue
+		}
+		items[i].Story = story
+		if author, ok := authorsByID[story.AuthorID]; ok {
+			items[i].Author = author
+		} else {
+			items[i].Warnings = append(items[i].Warnings, SectionWarning{Section: "author", Reason: SectionReasonError})
+		}
+	}
+
+	s.Metrics.IncrementCounter("story.fetch.success", []string{
+		"type:batch",
+	})
+	s.Metrics.RecordTiming("story.display.duration", time.Since(start), []string{
+		"type:batch",
+	})
+	s.Logger.Info(ctx, "Fetched story batch",
+		logger.Int("requested", len(storyIDs)),
+		logger.Int("missing", missing),
+	)
+	return items, nil
+}
+
+// uniqueIDs returns ids without duplicates, keeping the first occurrence of each
+func uniqueIDs(ids []uint) []uint {
+	seen := make(map[uint]bool, len(ids))
+	unique := make([]uint, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	return unique
+}
+
+// LikeStory records that the current user likes the story and returns the updated story.
+// Drafts cannot be liked by anyone who may not read them.
+func (s *StoryService) LikeStory(ctx context.Context, storyID string) (*storydomain.Story, bool, error) {
+	userID := appctx.FromContext(ctx).UserID()
+	if _, err := GetVisibleStory(ctx, s.storyProvider, s.authorProvider, storyID); err != nil {
+		return nil, false, err
+	}
+	story, added, err := s.storyProvider.LikeStory(ctx, storyID, userID)
+	if err != nil {
+		s.Logger.Error(ctx, "Failed to like story",
+			logger.String("story_id", storyID),
+			logger.String("error", err.Error()),
+		)
+		return nil, false, err
+	}
+	return story, added, nil
+}
+
+// CreateStory creates a draft story for authorID. The current user must own the
+// author, as stories are written on behalf of the authors a user manages.
+func (s *StoryService) CreateStory(ctx context.Context, authorID uint, title, content string) (*storydomain.Story, error) {
+	userID := appctx.FromContext(ctx).UserID()
+	owner, err := s.authorProvider.IsAuthorOwner(ctx, authorID, userID)
+	if err != nil {
+		s.Logger.Error(ctx, "Failed to check author ownership",
+			logger.String("author_id", strconv.FormatUint(uint64(authorID), 10)),
+			logger.String("error", err.Error()),
+		)
+		return nil, err
+	}
+	if !owner {
+		return nil, errors.NewForbiddenError("stories can only be written for authors you own")
+	}
+
+	story, err := s.storyProvider.CreateStory(ctx, title, content, strconv.FormatUint(uint64(authorID), 10))
+	if err != nil {
+		s.Logger.Error(ctx, "Failed to create story",
+			logger.String("author_id", strconv.FormatUint(uint64(authorID), 10)),
+			logger.String("error", err.Error()),
+		)
+		return nil, err
+	}
+	return story, nil
+}
+
+// UpdateStory replaces the title and content of a story
+func (s *StoryService) UpdateStory(ctx context.Context, storyID string, title, content string) (*storydomain.Story, error) {
+	story, err := s.storyProvider.UpdateStory(ctx, storyID, title, content)
+	if err != nil {
+		s.Logger.Error(ctx, "Failed to update story",
+			logger.String("story_id", storyID),
+			logger.String("error", err.Error()),
+		)
+		return nil, err
+	}
+	return story, nil
+}
+
+// PublishStory publishes a story; an already published story is returned unchanged
+func (s *StoryService) PublishStory(ctx context.Context, storyID string) (*storydomain.Story, error) {
+	story, err := s.storyProvider.PublishStory(ctx, storyID)
+	if err != nil {
+		s.Logger.Error(ctx, "Failed to publish story",
+			logger.String("story_id", storyID),
+			logger.String("error", err.Error()),
+		)
+		return nil, err
+	}
+	return story, nil
+}
+
+// DeleteStory deletes a story
+func (s *StoryService) DeleteStory(ctx context.Context, storyID string) error {
+	if err := s.storyProvider.DeleteStory(ctx, storyID); err != nil {
+		s.Logger.Error(ctx, "Failed to delete story",
+			logger.String("story_id", storyID),
+			logger.String("error", err.Error()),
+		)
+		return err
+	}
+	return nil
+}
+
+// recordView counts a story view for analytics. A failure here must not fail the read.
+func (s *StoryService) recordView(ctx context.Context, storyID, authorID uint) {
+	if err := s.analyticsProvider.RecordView(ctx, storyID, authorID); err != nil {
+		s.Logger.Warn(ctx, "Failed to record story view",
+			logger.String("story_id", strconv.FormatUint(uint64(storyID), 10)),
+			logger.String("error", err.Error()),
+		)
+	}
 }
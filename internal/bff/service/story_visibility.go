@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+
+	data "go-monolith/internal/bff/data"
+	storydomain "go-monolith/internal/modules/story/domain"
+	appctx "go-monolith/pkg/context"
+	"go-monolith/pkg/errors"
+)
+
+// StoryVisibility decides which stories the current user may read. Published
+// stories are public; a draft is visible only to the owners of its author. The
+// ownership of each author is checked once, so it can filter a list of stories.
+type StoryVisibility struct {
+	authors data.AuthorDataProvider
+	owned   map[uint]bool
+}
+
+func NewStoryVisibility(authors data.AuthorDataProvider) *StoryVisibility {
+	return &StoryVisibility{
+		authors: authors,
+		owned:   make(map[uint]bool),
+	}
+}
+
+// CanView reports whether the current user may read story
+func (v *StoryVisibility) CanView(ctx context.Context, story *storydomain.Story) (bool, error) {
+	if story.PublishedAt != nil {
+		return true, nil
+	}
+	return v.OwnsAuthor(ctx, story.AuthorID)
+}
+
+// OwnsAuthor reports whether the current user owns the author. Anonymous users own none.
+func (v *StoryVisibility) OwnsAuthor(ctx context.Context, authorID uint) (bool, error) {
+	if owned, ok := v.owned[authorID]; ok {
+		return owned, nil
+	}
+	userID := appctx.FromContext(ctx).UserID()
+	if userID == "" {
+		return false, nil
+	}
+	owned, err := v.authors.IsAuthorOwner(ctx, authorID, userID)
+	if err != nil {
+		return false, err
+	}
+	v.owned[authorID] = owned
+	return owned, nil
+}
+
+// GetVisibleStory loads a story the current user may read. A draft of someone
+// else's author is reported as not found, so its existence is not revealed.
+func GetVisibleStory(ctx context.Context, sp data.StoryDataProvider, ap data.AuthorDataProvider, storyID string) (*storydomain.Story, error) {
+	story, err := sp.GetStory(ctx, storyID)
+	if err != nil {
+		return nil, err
+	}
+	visible, err := NewStoryVisibility(ap).CanView(ctx, story)
+	if err != nil {
+		return nil, err
+	}
+	if !visible {
+		return nil, errors.NewNotFoundError("story", storyID)
+	}
+	return story, nil
+}
@@ -0,0 +1,159 @@
+package version
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"go-monolith/pkg/errors"
+	"go-monolith/pkg/metrics"
+	"go-monolith/pkg/problem"
+)
+
+// ClientHeader names the calling application in usage metrics, e.g. ios-app/5.2
+const ClientHeader = "X-Client-Name"
+
+// Deprecation declares that a version or a single route is being retired. A route's
+// own deprecation takes precedence over its version's.
+type Deprecation struct {
+	// Since is when the route was deprecated
+	Since time.Time
+	// Sunset is when the route stops being served; a configured sunset replaces it
+	Sunset time.Time
+	// Link points to documentation of the deprecation
+	Link string
+	// Migration tells clients what to call instead
+	Migration string
+}
+
+var (
+	mu           sync.RWMutex
+	sunsets      = map[string]time.Time{}
+	usageMetrics *metrics.Client
+)
+
+// Configure sets the sunsets configured for versions and routes, keyed as in
+// ParseSunsets, and the client usage is counted with
+func Configure(configured map[string]time.Time, m *metrics.Client) {
+	mu.Lock()
+	defer mu.Unlock()
+	sunsets = configured
+	usageMetrics = m
+}
+
+// ParseSunsets parses sunsets for whole versions or single routes written as
+// "v1.2=2027-01-01; v1.2 POST /authors=2026-12-01T00:00:00Z"
+func ParseSunsets(spec string) (map[string]time.Time, error) {
+	configured := map[string]time.Time{}
+	for _, entry := range strings.Split(spec, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		target, value, ok := strings.Cut(entry, "=")
+		target, value = strings.Join(strings.Fields(target), " "), strings.TrimSpace(value)
+		if !ok || target == "" || value == "" {
+			return nil, fmt.Errorf("invalid sunset entry %q, expected target=date", entry)
+		}
+		sunset, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			if sunset, err = time.Parse(time.DateOnly, value); err != nil {
+				return nil, fmt.Errorf("invalid sunset date %q, expected RFC 3339 or YYYY-MM-DD", value)
+			}
+		}
+		configured[target] = sunset
+	}
+	return configured, nil
+}
+
+// lifecycle counts usage of a route and, once it is deprecated, announces its
+// retirement with Deprecation, Sunset and Link headers. Past its sunset the route
+// responds 410 Gone with the migration hint instead of being served.
+func lifecycle(v Version, route Route) gin.HandlerFunc {
+	routeName := route.Method + " " + route.Path
+	return func(c *gin.Context) {
+		mu.RLock()
+		deprecation, deprecated := effectiveDeprecation(v, route)
+		m := usageMetrics
+		mu.RUnlock()
+
+		tags := []string{
+			"version:" + v.Name,
+			"route:" + routeName,
+			"client:" + clientName(c),
+			"deprecated:" + strconv.FormatBool(deprecated),
+		}
+		if m != nil {
+			m.IncrementCounter("api.version.requests", tags)
+		}
+		if !deprecated {
+			return
+		}
+
+		header := c.Writer.Header()
+		// Deprecation is a structured date as in RFC 9745, or true when it is unknown
+		if deprecation.Since.IsZero() {
+			header.Set("Deprecation", "true")
+		} else {
+			header.Set("Deprecation", "@"+strconv.FormatInt(deprecation.Since.Unix(), 10))
+		}
+		if !deprecation.Sunset.IsZero() {
+			header.Set("Sunset", deprecation.Sunset.UTC().Format(http.TimeFormat))
+		}
+		if deprecation.Link != "" {
+			header.Add("Link", "<"+deprecation.Link+`>; rel="deprecation"`)
+		}
+
+		if deprecation.Sunset.IsZero() || time.Now().Before(deprecation.Sunset) {
+			return
+		}
+		if m != nil {
+			m.IncrementCounter("api.version.gone", tags)
+		}
+		extensions := map[string]interface{}{}
+		if deprecation.Migration != "" {
+			extensions["migration"] = deprecation.Migration
+		}
+		if deprecation.Link != "" {
+			extensions["link"] = deprecation.Link
+		}
+		detail := fmt.Sprintf("%s %s was retired on %s", v.Name, routeName, deprecation.Sunset.UTC().Format(time.DateOnly))
+		problem.Abort(c, problem.WithExtensions(errors.NewHTTPError(http.StatusGone, detail), extensions))
+	}
+}
+
+// effectiveDeprecation merges the declared deprecation of the route or its version
+// with the configured sunset. A configured sunset deprecates a route on its own.
+func effectiveDeprecation(v Version, route Route) (Deprecation, bool) {
+	var deprecation Deprecation
+	deprecated := false
+	if route.Deprecation != nil {
+		deprecation, deprecated = *route.Deprecation, true
+	} else if v.Deprecation != nil {
+		deprecation, deprecated = *v.Deprecation, true
+	}
+
+	if sunset, ok := sunsets[v.Name+" "+route.Method+" "+route.Path]; ok {
+		deprecation.Sunset, deprecated = sunset, true
+	} else if sunset, ok := sunsets[v.Name]; ok {
+		deprecation.Sunset, deprecated = sunset, true
+	}
+	return deprecation, deprecated
+}
+
+// clientName identifies the caller for usage metrics, kept short and tag-safe
+func clientName(c *gin.Context) string {
+	name := strings.ToLower(strings.TrimSpace(c.GetHeader(ClientHeader)))
+	if name == "" {
+		return "unknown"
+	}
+	if len(name) > 64 {
+		name = name[:64]
+	}
+	return strings.NewReplacer(",", "_", "|", "_", "#", "_", " ", "_").Replace(name)
+}
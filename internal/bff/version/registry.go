@@ -0,0 +1,159 @@
+package version
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"go-monolith/pkg/auth"
+	appctx "go-monolith/pkg/context"
+	"go-monolith/pkg/errors"
+	"go-monolith/pkg/problem"
+)
+
+// HeaderName is the request header selecting a version, echoed on every response
+const HeaderName = "X-API-Version"
+
+// Registry serves the routes of the declared versions. Each route is mounted under
+// its version's prefix, and the union of all routes is mounted without one, where
+// the version is negotiated per request.
+type Registry struct {
+	versions []Version
+	byName   map[string]*mountedVersion
+	latest   string
+}
+
+type mountedVersion struct {
+	name   string
+	routes map[string][]gin.HandlerFunc
+}
+
+// NewRegistry creates a registry of versions, declared oldest first. Requests that
+// do not select a version are served by the last one.
+func NewRegistry(versions ...Version) *Registry {
+	r := &Registry{
+		versions: versions,
+		byName:   make(map[string]*mountedVersion, len(versions)),
+	}
+	if len(versions) > 0 {
+		r.latest = versions[len(versions)-1].Name
+	}
+	return r
+}
+
+// Versions returns the names of the declared versions, oldest first
+func (r *Registry) Versions() []string {
+	names := make([]string, len(r.versions))
+	for i, v := range r.versions {
+		names[i] = v.Name
+	}
+	return names
+}
+
+// Latest returns the name of the version serving requests that do not select one
+func (r *Registry) Latest() string {
+	return r.latest
+}
+
+// Mount registers every route under its version's prefix, such as
+// /v2.0/stories/:id, and once without a prefix, such as /stories/:id, for
+// requests selecting the version with a header or media type instead
+func (r *Registry) Mount(router gin.IRouter, verifier auth.PermissionVerifier) {
+	var unversioned []Route
+	seen := map[string]bool{}
+	for _, v := range r.versions {
+		mounted := &mountedVersion{name: v.Name, routes: make(map[string][]gin.HandlerFunc, len(v.Routes))}
+		r.byName[v.Name] = mounted
+		for _, route := range v.Routes {
+			handlers := []gin.HandlerFunc{
+				useVersion(v.Name),
+				lifecycle(v, route),
+				auth.RequirePermission(verifier, route.Action, route.Resource),
+				route.Handler,
+			}
+			mounted.routes[route.key()] = handlers
+			router.Handle(route.Method, "/"+v.Name+route.Path, handlers...)
+
+			if !seen[route.key()] {
+				seen[route.key()] = true
+				unversioned = append(unversioned, route)
+			}
+		}
+	}
+
+	for _, route := range unversioned {
+		router.Handle(route.Method, route.Path, r.negotiate(route.key()))
+	}
+}
+
+// negotiate serves an unversioned route with the version the request selects
+func (r *Registry) negotiate(key string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Header("Vary", "Accept, "+HeaderName)
+		v, status, err := r.selectVersion(c)
+		if err != "" {
+			c.Header(HeaderName, r.latest)
+			problem.Abort(c, problem.WithExtensions(errors.NewHTTPError(status, err), map[string]interface{}{
+				"supportedVersions": r.Versions(),
+			}))
+			return
+		}
+
+		handlers, ok := v.routes[key]
+		if !ok {
+			c.Header(HeaderName, v.name)
+			problem.Abort(c, errors.NewHTTPError(http.StatusNotFound, "route is not available in API version "+v.name))
+			return
+		}
+		// The chain runs inside this handler, so each step's abort is checked here
+		for _, handler := range handlers {
+			handler(c)
+			if c.IsAborted() {
+				return
+			}
+		}
+	}
+}
+
+// selectVersion picks the version from the X-API-Version header, then from a
+// versioned media type in Accept, then falls back to the latest version. An
+// unsupported header is a bad request; an Accept naming only unsupported versions
+// is not acceptable.
+func (r *Registry) selectVersion(c *gin.Context) (*mountedVersion, int, string) {
+	if header := c.GetHeader(HeaderName); header != "" {
+		if v, ok := r.byName[normalize(header)]; ok {
+			return v, 0, ""
+		}
+		return nil, http.StatusBadRequest, "unsupported API version " + header
+	}
+
+	requested := false
+	for _, mediaRange := range strings.Split(c.GetHeader("Accept"), ",") {
+		mediaType, _, _ := strings.Cut(mediaRange, ";")
+		mediaType = strings.ToLower(strings.TrimSpace(mediaType))
+		if !strings.HasPrefix(mediaType, MediaTypePrefix) || !strings.HasSuffix(mediaType, MediaTypeSuffix) {
+			continue
+		}
+		requested = true
+		name := strings.TrimSuffix(strings.TrimPrefix(mediaType, MediaTypePrefix), MediaTypeSuffix)
+		if v, ok := r.byName[normalize(name)]; ok {
+			return v, 0, ""
+		}
+	}
+	if requested {
+		return nil, http.StatusNotAcceptable, "none of the accepted media types names a supported API version"
+	}
+
+	return r.byName[r.latest], 0, ""
+}
+
+// useVersion records the version serving the request, overriding any version the
+// client asked for, and reports it in the response
+func useVersion(name string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		appctx.FromContext(c.Request.Context()).WithAPIVersion(name)
+		c.Header(HeaderName, name)
+		c.Header("Vary", "Accept, "+HeaderName)
+	}
+}
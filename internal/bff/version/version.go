@@ -0,0 +1,92 @@
+package version
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// MediaTypePrefix and MediaTypeSuffix frame a version in an Accept media type, as in
+// application/vnd.monolith.v2.0+json
+const (
+	MediaTypePrefix = "application/vnd.monolith."
+	MediaTypeSuffix = "+json"
+)
+
+// Route declares one endpoint of a version. Path is relative to the version, so
+// /stories/:id is served at /v2.0/stories/:id and, when negotiated, at /stories/:id.
+type Route struct {
+	Method   string
+	Path     string
+	Action   string
+	Resource string
+	Handler  gin.HandlerFunc
+	// Deprecation retires this route alone; nil leaves it to the version
+	Deprecation *Deprecation
+}
+
+// Version declares the routes an API version serves
+type Version struct {
+	Name   string
+	Routes []Route
+	// Deprecation retires every route of the version
+	Deprecation *Deprecation
+}
+
+// Derive declares a version serving the routes of base, with routes replacing the
+// base route of the same method and path or adding a new one. Removed routes are
+// given as a Route with a nil Handler. The base version's deprecation is not
+// inherited.
+func Derive(base Version, name string, routes ...Route) Version {
+	derived := Version{Name: name}
+	overrides := make(map[string]Route, len(routes))
+	for _, route := range routes {
+		overrides[route.key()] = route
+	}
+	for _, route := range base.Routes {
+		if override, ok := overrides[route.key()]; ok {
+			route = override
+			delete(overrides, route.key())
+		}
+		if route.Handler != nil {
+			derived.Routes = append(derived.Routes, route)
+		}
+	}
+	for _, route := range routes {
+		if _, ok := overrides[route.key()]; ok && route.Handler != nil {
+			derived.Routes = append(derived.Routes, route)
+		}
+	}
+	return derived
+}
+
+// GET, POST, PUT and DELETE declare routes of the common methods
+func GET(path, action, resource string, handler gin.HandlerFunc) Route {
+	return Route{Method: http.MethodGet, Path: path, Action: action, Resource: resource, Handler: handler}
+}
+
+func POST(path, action, resource string, handler gin.HandlerFunc) Route {
+	return Route{Method: http.MethodPost, Path: path, Action: action, Resource: resource, Handler: handler}
+}
+
+func PUT(path, action, resource string, handler gin.HandlerFunc) Route {
+	return Route{Method: http.MethodPut, Path: path, Action: action, Resource: resource, Handler: handler}
+}
+
+func DELETE(path, action, resource string, handler gin.HandlerFunc) Route {
+	return Route{Method: http.MethodDelete, Path: path, Action: action, Resource: resource, Handler: handler}
+}
+
+func (r Route) key() string {
+	return r.Method + " " + r.Path
+}
+
+// normalize accepts a version written with or without its v prefix
+func normalize(name string) string {
+	name = strings.TrimSpace(name)
+	if name == "" || strings.HasPrefix(name, "v") {
+		return name
+	}
+	return "v" + name
+}
@@ -0,0 +1,30 @@
+package analytics
+
+import (
+	"gorm.io/gorm"
+
+	"go-monolith/internal/modules/analytics/repository"
+	"go-monolith/internal/modules/analytics/service"
+	storydomain "go-monolith/internal/modules/story/domain"
+	"go-monolith/pkg/events"
+	"go-monolith/pkg/logger"
+	"go-monolith/pkg/metrics"
+)
+
+type Module struct {
+	AnalyticsService *service.AnalyticsService
+	CompactionJob    *service.CompactionJob
+}
+
+func NewModule(db *gorm.DB, bus *events.Bus, logger logger.Logger, metrics *metrics.Client) *Module {
+	repo := repository.NewAnalyticsRepository(db)
+	analyticsService := service.NewAnalyticsService(repo, bus, logger, metrics)
+
+	// Feed the rollups from story engagement
+	bus.Subscribe(storydomain.EventStoryEngaged, analyticsService.HandleStoryEngaged)
+
+	return &Module{
+		AnalyticsService: analyticsService,
+		CompactionJob:    service.NewCompactionJob(analyticsService),
+	}
+}
@@ -0,0 +1,30 @@
+package domain
+
+import (
+	"fmt"
+
+	"go-monolith/pkg/errors"
+)
+
+// AnalyticsError represents analytics-specific domain errors
+type AnalyticsError struct {
+	errors.BaseError
+}
+
+func NewAnalyticsError(message string) error {
+	return &AnalyticsError{
+		BaseError: errors.BaseError{
+			Kind:    errors.ErrKindValidation,
+			Message: message,
+		},
+	}
+}
+
+// Domain-specific error constructors
+func NewInvalidDateError(field, value string) error {
+	return NewAnalyticsError(fmt.Sprintf("invalid %s date '%s', expected YYYY-MM-DD", field, value))
+}
+
+func NewRangeTooLongError() error {
+	return NewAnalyticsError(fmt.Sprintf("date range cannot exceed %d days", MaxRangeDays))
+}
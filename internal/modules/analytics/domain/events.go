@@ -0,0 +1,23 @@
+package domain
+
+import "time"
+
+// Event names published by the analytics module
+const (
+	EventViewsCompacted = "analytics.views_compacted"
+)
+
+// StoryViews is the number of views of one story
+type StoryViews struct {
+	StoryID uint
+	Views   int64
+}
+
+// ViewsCompacted is published after a compaction with the views it folded per story,
+// so the modules keeping view totals can add them
+type ViewsCompacted struct {
+	Views       []StoryViews
+	CompactedAt time.Time
+}
+
+func (ViewsCompacted) EventName() string { return EventViewsCompacted }
@@ -0,0 +1,115 @@
+package domain
+
+import (
+	"time"
+)
+
+const (
+	// DateLayout is the format of days in requests and responses
+	DateLayout = "2006-01-02"
+	// DefaultRangeDays is the number of days returned when no range is given
+	DefaultRangeDays = 30
+	// MaxRangeDays is the longest range that can be requested at once
+	MaxRangeDays = 366
+)
+
+// EngagementEvent is a single raw engagement waiting to be rolled up
+type EngagementEvent struct {
+	StoryID    uint
+	AuthorID   uint
+	Kind       string
+	OccurredAt time.Time
+}
+
+// DailyStats holds the engagement totals of one day
+type DailyStats struct {
+	Day      time.Time
+	Views    int64
+	Likes    int64
+	Comments int64
+}
+
+// Add accumulates other into s
+func (s *DailyStats) Add(other DailyStats) {
+	s.Views += other.Views
+	s.Likes += other.Likes
+	s.Comments += other.Comments
+}
+
+// DateRange is an inclusive range of UTC days
+type DateRange struct {
+	From time.Time
+	To   time.Time
+}
+
+// NewDateRange parses from and to (YYYY-MM-DD). Missing bounds default to
+// the DefaultRangeDays ending today.
+func NewDateRange(from, to string, now time.Time) (DateRange, error) {
+	end := truncateDay(now)
+	if to != "" {
+		parsed, err := time.Parse(DateLayout, to)
+		if err != nil {
+			return DateRange{}, NewInvalidDateError("to", to)
+		}
+		end = parsed
+	}
+
+	start := end.AddDate(0, 0, -(DefaultRangeDays - 1))
+	if from != "" {
+		parsed, err := time.Parse(DateLayout, from)
+		if err != nil {
+			return DateRange{}, NewInvalidDateError("from", from)
+		}
+		start = parsed
+	}
+
+	if start.After(end) {
+		return DateRange{}, NewAnalyticsError("from must not be after to")
+	}
+	if end.Sub(start) >= MaxRangeDays*24*time.Hour {
+		return DateRange{}, NewRangeTooLongError()
+	}
+
+	return DateRange{From: start, To: end}, nil
+}
+
+// Days returns the number of days in the range
+func (r DateRange) Days() int {
+	return int(r.To.Sub(r.From)/(24*time.Hour)) + 1
+}
+
+// AuthorStats is the daily engagement time series of an author's stories
+type AuthorStats struct {
+	AuthorID uint
+	Range    DateRange
+	Series   []DailyStats
+	Totals   DailyStats
+}
+
+// NewAuthorStats builds a gap-free series over the range from the days that had engagement
+func NewAuthorStats(authorID uint, r DateRange, rows []DailyStats) *AuthorStats {
+	byDay := make(map[string]DailyStats, len(rows))
+	for _, row := range rows {
+		byDay[row.Day.Format(DateLayout)] = row
+	}
+
+	stats := &AuthorStats{
+		AuthorID: authorID,
+		Range:    r,
+		Series:   make([]DailyStats, 0, r.Days()),
+	}
+	for day := r.From; !day.After(r.To); day = day.AddDate(0, 0, 1) {
+		entry := DailyStats{Day: day}
+		if row, ok := byDay[day.Format(DateLayout)]; ok {
+			entry.Add(row)
+		}
+		stats.Totals.Add(entry)
+		stats.Series = append(stats.Series, entry)
+	}
+	return stats
+}
+
+func truncateDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
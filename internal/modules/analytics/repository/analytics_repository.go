@@ -0,0 +1,209 @@
+package repository
+
+import (
+	"context"
+	stderrors "errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	"go-monolith/internal/modules/analytics/domain"
+	"go-monolith/pkg/errors"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+// engagementEventModel is a raw engagement that has not been rolled up yet
+type engagementEventModel struct {
+	ID         uint      `gorm:"primaryKey;autoIncrement"`
+	StoryID    uint      `gorm:"not null"`
+	AuthorID   uint      `gorm:"not null"`
+	Kind       string    `gorm:"type:varchar(16);not null"`
+	OccurredAt time.Time `gorm:"not null"`
+}
+
+// TableName sets the insert table name for this struct type
+func (engagementEventModel) TableName() string {
+	return "analytics_engagement_events"
+}
+
+// storyDailyStatsModel holds the daily rollup of a story
+type storyDailyStatsModel struct {
+	StoryID  uint      `gorm:"primaryKey"`
+	Day      time.Time `gorm:"primaryKey;type:date"`
+	AuthorID uint      `gorm:"not null;index"`
+	Views    int64     `gorm:"not null;default:0"`
+	Likes    int64     `gorm:"not null;default:0"`
+	Comments int64     `gorm:"not null;default:0"`
+}
+
+// TableName sets the insert table name for this struct type
+func (storyDailyStatsModel) TableName() string {
+	return "story_daily_stats"
+}
+
+// authorDailyStatsModel holds the daily rollup of all stories of an author
+type authorDailyStatsModel struct {
+	AuthorID uint      `gorm:"primaryKey"`
+	Day      time.Time `gorm:"primaryKey;type:date"`
+	Views    int64     `gorm:"not null;default:0"`
+	Likes    int64     `gorm:"not null;default:0"`
+	Comments int64     `gorm:"not null;default:0"`
+}
+
+// TableName sets the insert table name for this struct type
+func (authorDailyStatsModel) TableName() string {
+	return "author_daily_stats"
+}
+
+// rollupColumns aggregates raw events into the per-kind counters
+const rollupColumns = `SUM(kind = 'view'), SUM(kind = 'like'), SUM(kind = 'comment')`
+
+// AnalyticsRepository records raw engagement and maintains the daily rollup tables
+type AnalyticsRepository interface {
+	RecordEvent(ctx context.Context, event *domain.EngagementEvent) error
+	Compact(ctx context.Context, batchSize int) (int64, []domain.StoryViews, error)
+	ListAuthorDaily(ctx context.Context, authorID uint, r domain.DateRange) ([]domain.DailyStats, error)
+	ListStoryDaily(ctx context.Context, storyID uint, r domain.DateRange) ([]domain.DailyStats, error)
+}
+
+type analyticsRepository struct {
+	db *gorm.DB
+}
+
+func NewAnalyticsRepository(db *gorm.DB) AnalyticsRepository {
+	return &analyticsRepository{db: db}
+}
+
+func (r *analyticsRepository) RecordEvent(ctx context.Context, event *domain.EngagementEvent) error {
+	model := &engagementEventModel{
+		StoryID:    event.StoryID,
+		AuthorID:   event.AuthorID,
+		Kind:       event.Kind,
+		OccurredAt: event.OccurredAt.UTC(),
+	}
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return wrapError(err)
+	}
+	return nil
+}
+
+// Compact folds up to batchSize of the oldest raw events into the daily rollups and
+// removes them, in one transaction. It returns the number of events compacted and
+// the views among them per story, which the story and author modules add to their
+// own totals.
+func (r *analyticsRepository) Compact(ctx context.Context, batchSize int) (int64, []domain.StoryViews, error) {
+	var compacted int64
+	var views []domain.StoryViews
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var maxID uint
+		err := tx.Raw(`SELECT COALESCE(MAX(id), 0) FROM (
+				SELECT id FROM analytics_engagement_events ORDER BY id LIMIT ?
+			) AS batch`, batchSize).Scan(&maxID).Error
+		if err != nil {
+			return err
+		}
+		if maxID == 0 {
+			return nil
+		}
+
+		err = tx.Raw(`SELECT story_id, COUNT(*) AS views
+			FROM analytics_engagement_events WHERE id <= ? AND kind = 'view'
+			GROUP BY story_id`, maxID).Scan(&views).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Exec(`INSERT INTO story_daily_stats (story_id, day, author_id, views, likes, comments)
+			SELECT story_id, DATE(occurred_at), author_id, `+rollupColumns+`
+			FROM analytics_engagement_events WHERE id <= ?
+			GROUP BY story_id, DATE(occurred_at), author_id
+			ON DUPLICATE KEY UPDATE
+				views = views + VALUES(views),
+				likes = likes + VALUES(likes),
+				comments = comments + VALUES(comments)`, maxID).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Exec(`INSERT INTO author_daily_stats (author_id, day, views, likes, comments)
+			SELECT author_id, DATE(occurred_at), `+rollupColumns+`
+			FROM analytics_engagement_events WHERE id <= ?
+			GROUP BY author_id, DATE(occurred_at)
+			ON DUPLICATE KEY UPDATE
+				views = views + VALUES(views),
+				likes = likes + VALUES(likes),
+				comments = comments + VALUES(comments)`, maxID).Error
+		if err != nil {
+			return err
+		}
+
+		result := tx.Where("id <= ?", maxID).Delete(&engagementEventModel{})
+		if result.Error != nil {
+			return result.Error
+		}
+		compacted = result.RowsAffected
+		return nil
+	})
+	if err != nil {
+		return 0, nil, wrapError(err)
+	}
+	return compacted, views, nil
+}
+
+func (r *analyticsRepository) ListAuthorDaily(ctx context.Context, authorID uint, dr domain.DateRange) ([]domain.DailyStats, error) {
+	var models []*authorDailyStatsModel
+	err := r.db.WithContext(ctx).
+		Where("author_id = ? AND day BETWEEN ? AND ?", authorID, dr.From, dr.To).
+		Order("day ASC").
+		Find(&models).Error
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	stats := make([]domain.DailyStats, len(models))
+	for i, model := range models {
+		stats[i] = domain.DailyStats{Day: model.Day, Views: model.Views, Likes: model.Likes, Comments: model.Comments}
+	}
+	return stats, nil
+}
+
+func (r *analyticsRepository) ListStoryDaily(ctx context.Context, storyID uint, dr domain.DateRange) ([]domain.DailyStats, error) {
+	var models []*storyDailyStatsModel
+	err := r.db.WithContext(ctx).
+		Where("story_id = ? AND day BETWEEN ? AND ?", storyID, dr.From, dr.To).
+		Order("day ASC").
+		Find(&models).Error
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	stats := make([]domain.DailyStats, len(models))
+	for i, model := range models {
+		stats[i] = domain.DailyStats{Day: model.Day, Views: model.Views, Likes: model.Likes, Comments: model.Comments}
+	}
+	return stats, nil
+}
+
+func wrapError(err error) error {
+	if isTransientError(err) {
+		return errors.NewTransientError(err)
+	}
+	return errors.NewUnexpectedError(err)
+}
+
+func isTransientError(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	if !stderrors.As(err, &mysqlErr) {
+		return false
+	}
+	// Common MySQL transient error codes
+	switch mysqlErr.Number {
+	case 1213, // Deadlock
+		1205, // Lock wait timeout
+		2006, // MySQL server has gone away
+		2013: // Lost connection to MySQL server
+		return true
+	}
+	return false
+}
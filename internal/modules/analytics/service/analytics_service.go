@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go-monolith/internal/modules/analytics/domain"
+	"go-monolith/internal/modules/analytics/repository"
+	storydomain "go-monolith/internal/modules/story/domain"
+	"go-monolith/pkg/events"
+	"go-monolith/pkg/logger"
+	"go-monolith/pkg/metrics"
+)
+
+// compactionBatchSize bounds the number of raw events folded per transaction
+const compactionBatchSize = 5000
+
+type AnalyticsService struct {
+	repo    repository.AnalyticsRepository
+	events  *events.Bus
+	logger  logger.Logger
+	metrics *metrics.Client
+}
+
+func NewAnalyticsService(repo repository.AnalyticsRepository, bus *events.Bus, logger logger.Logger, metrics *metrics.Client) *AnalyticsService {
+	return &AnalyticsService{
+		repo:    repo,
+		events:  bus,
+		logger:  logger,
+		metrics: metrics,
+	}
+}
+
+// Event Handlers
+
+// HandleStoryEngaged records a story engagement for the next rollup
+func (s *AnalyticsService) HandleStoryEngaged(ctx context.Context, event events.Event) {
+	engaged, ok := event.(storydomain.StoryEngaged)
+	if !ok {
+		return
+	}
+
+	err := s.repo.RecordEvent(ctx, &domain.EngagementEvent{
+		StoryID:    engaged.StoryID,
+		AuthorID:   engaged.AuthorID,
+		Kind:       string(engaged.Kind),
+		OccurredAt: engaged.OccurredAt,
+	})
+	if err != nil {
+		s.logger.Error(ctx, "Failed to record engagement event",
+			logger.String("error", err.Error()),
+			logger.String("story_id", fmt.Sprintf("%d", engaged.StoryID)),
+			logger.String("kind", string(engaged.Kind)))
+		s.metrics.IncrementCounter("analytics.record.error", []string{
+			"kind:" + string(engaged.Kind),
+		})
+	}
+}
+
+// Write Operations (Commands)
+
+// RecordView records a read of a story for the next rollup. Story and author view
+// totals are only updated when the view is compacted, so a read costs one insert.
+func (s *AnalyticsService) RecordView(ctx context.Context, storyID, authorID uint) error {
+	err := s.repo.RecordEvent(ctx, &domain.EngagementEvent{
+		StoryID:    storyID,
+		AuthorID:   authorID,
+		Kind:       string(storydomain.EngagementView),
+		OccurredAt: time.Now(),
+	})
+	if err != nil {
+		s.metrics.IncrementCounter("analytics.record.error", []string{
+			"kind:" + string(storydomain.EngagementView),
+		})
+		return err
+	}
+	return nil
+}
+
+// Compact folds all pending raw events into the daily rollup tables. The views of
+// each compacted batch are published as ViewsCompacted.
+func (s *AnalyticsService) Compact(ctx context.Context) (int64, error) {
+	start := time.Now()
+	var total int64
+	for {
+		compacted, views, err := s.repo.Compact(ctx, compactionBatchSize)
+		if err != nil {
+			s.logger.Error(ctx, "Failed to compact engagement events",
+				logger.String("error", err.Error()),
+				logger.Int64("compacted", total))
+			s.metrics.IncrementCounter("analytics.compact.error", nil)
+			return total, err
+		}
+		if len(views) > 0 {
+			s.events.Publish(ctx, domain.ViewsCompacted{Views: views, CompactedAt: time.Now()})
+		}
+		total += compacted
+		if compacted < compactionBatchSize || ctx.Err() != nil {
+			break
+		}
+	}
+
+	if total > 0 {
+		s.logger.Info(ctx, "Compacted engagement events", logger.Int64("count", total))
+	}
+	s.metrics.RecordTiming("analytics.compact.duration", time.Since(start), nil)
+	return total, nil
+}
+
+// Read Operations (Queries)
+
+// GetAuthorStats returns the daily engagement series of an author between from and to (YYYY-MM-DD)
+func (s *AnalyticsService) GetAuthorStats(ctx context.Context, authorID uint, from, to string) (*domain.AuthorStats, error) {
+	start := time.Now()
+	s.logger.Debug(ctx, "Getting author stats",
+		logger.String("author_id", fmt.Sprintf("%d", authorID)),
+		logger.String("from", from),
+		logger.String("to", to))
+
+	dateRange, err := domain.NewDateRange(from, to, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.repo.ListAuthorDaily(ctx, authorID, dateRange)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to get author stats",
+			logger.String("error", err.Error()),
+			logger.String("author_id", fmt.Sprintf("%d", authorID)))
+		s.metrics.IncrementCounter("analytics.fetch.error", []string{
+			"type:author",
+			"error_type:repository",
+		})
+		return nil, err
+	}
+
+	s.metrics.RecordTiming("analytics.fetch.duration", time.Since(start), []string{
+		"type:author",
+	})
+	return domain.NewAuthorStats(authorID, dateRange, rows), nil
+}
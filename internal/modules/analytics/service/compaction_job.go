@@ -0,0 +1,22 @@
+package service
+
+import "context"
+
+// CompactionJob periodically rolls raw engagement events up into the daily tables.
+// It implements scheduler.Job.
+type CompactionJob struct {
+	service *AnalyticsService
+}
+
+func NewCompactionJob(service *AnalyticsService) *CompactionJob {
+	return &CompactionJob{service: service}
+}
+
+func (j *CompactionJob) Name() string {
+	return "analytics.compaction"
+}
+
+func (j *CompactionJob) Run(ctx context.Context) error {
+	_, err := j.service.Compact(ctx)
+	return err
+}
@@ -3,20 +3,38 @@ package author
 import (
 	"gorm.io/gorm"
 
+	"go-monolith/internal/modules/author/domain"
 	"go-monolith/internal/modules/author/repository"
 	"go-monolith/internal/modules/author/service"
+	storydomain "go-monolith/internal/modules/story/domain"
+	"go-monolith/pkg/events"
 	"go-monolith/pkg/logger"
 	"go-monolith/pkg/metrics"
 )
 
 type Module struct {
-	AuthorService *service.AuthorService
+	AuthorService     *service.AuthorService
+	StatsService      *service.StatsService
+	StatsReconcileJob *service.StatsReconcileJob
+	OwnershipService  *service.OwnershipService
 }
 
-func NewModule(db *gorm.DB, logger logger.Logger, metrics *metrics.Client) *Module {
+func NewModule(db *gorm.DB, bus *events.Bus, stories service.StoryCatalog, deletePolicy domain.DeletePolicy, logger logger.Logger, metrics *metrics.Client) *Module {
 	repo := repository.NewAuthorRepository(db)
+	statsService := service.NewStatsService(repository.NewStatsRepository(db), logger, metrics)
+
+	// Keep the author aggregates up to date from story events
+	bus.Subscribe(storydomain.EventStoryPublished, statsService.HandleStoryPublished)
+	bus.Subscribe(storydomain.EventStoryEngaged, statsService.HandleStoryEngaged)
+	bus.Subscribe(storydomain.EventViewsAdded, statsService.HandleViewsAdded)
+	bus.Subscribe(storydomain.EventStoryDeleted, statsService.HandleStoryDeleted)
+	bus.Subscribe(domain.EventAuthorDeleted, statsService.HandleAuthorDeleted)
+	bus.Subscribe(domain.EventAuthorsMerged, statsService.HandleAuthorsMerged)
 
 	return &Module{
-		AuthorService: service.NewAuthorService(repo, logger, metrics),
+		AuthorService:     service.NewAuthorService(repo, stories, deletePolicy, bus, logger, metrics),
+		StatsService:      statsService,
+		StatsReconcileJob: service.NewStatsReconcileJob(statsService),
+		OwnershipService:  service.NewOwnershipService(repository.NewOwnershipRepository(db), repo, logger, metrics),
 	}
 }
@@ -18,6 +18,22 @@ type Author struct {
 	Slug            string `validate:"required,min=8"`
 	CreatedAt       time.Time
 	UpdatedAt       time.Time
+
+	// Profile details, see UpdateProfile
+	Bio      string
+	Location string
+	Links    []Link
+
+	// Verification badge, see Verify
+	Verified   bool
+	VerifiedBy string
+	VerifiedAt *time.Time
+
+	// Stats is only populated when requested and is read-only here
+	Stats *Stats
+
+	// StoryCount is computed by the repository when listing and is read-only here
+	StoryCount int64
 }
 
 var validate = validator.New()
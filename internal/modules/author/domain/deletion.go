@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"fmt"
+
+	"go-monolith/pkg/errors"
+)
+
+// DeleteMode decides what happens to an author's stories when the author is deleted
+type DeleteMode string
+
+const (
+	// DeleteBlock refuses to delete authors who still have stories
+	DeleteBlock DeleteMode = "block"
+	// DeleteReassign moves the stories to a configured fallback author
+	DeleteReassign DeleteMode = "reassign"
+	// DeleteCascade soft-deletes the author together with its stories
+	DeleteCascade DeleteMode = "cascade"
+)
+
+// DeletePolicy is the configured author deletion policy
+type DeletePolicy struct {
+	Mode DeleteMode
+	// ReassignTo is the author receiving the stories under DeleteReassign
+	ReassignTo uint
+}
+
+// NewDeletePolicy validates a deletion policy. An empty mode defaults to DeleteBlock.
+func NewDeletePolicy(mode string, reassignTo uint) (DeletePolicy, error) {
+	policy := DeletePolicy{Mode: DeleteMode(mode), ReassignTo: reassignTo}
+	switch policy.Mode {
+	case "":
+		policy.Mode = DeleteBlock
+	case DeleteBlock, DeleteCascade:
+	case DeleteReassign:
+		if reassignTo == 0 {
+			return DeletePolicy{}, NewAuthorError("the reassign delete policy requires an author to reassign stories to")
+		}
+	default:
+		return DeletePolicy{}, NewAuthorError(fmt.Sprintf("invalid delete policy '%s', expected block, reassign or cascade", mode))
+	}
+	return policy, nil
+}
+
+// NewAuthorHasStoriesError is returned when the block policy prevents a deletion
+func NewAuthorHasStoriesError(id uint, stories int64) error {
+	return errors.NewConflictError(fmt.Sprintf("author %d still has %d stories", id, stories))
+}
@@ -39,6 +39,23 @@ func NewAuthorNotFoundError(id string) error {
 	return errors.NewNotFoundError("author", id)
 }
 
+// NewAuthorAlreadyExistsError is returned when a slug is taken by another author
 func NewAuthorAlreadyExistsError(slug string) error {
-	return NewAuthorError("author with slug '" + slug + "' already exists")
+	return errors.NewConflictError("author with slug '" + slug + "' already exists")
+}
+
+func NewInvalidSortError(sort string) error {
+	return NewAuthorError("invalid sort '" + sort + "', expected name, createdAt or storyCount")
+}
+
+func NewInvalidOrderError(order string) error {
+	return NewAuthorError("invalid order '" + order + "', expected asc or desc")
+}
+
+func NewInvalidCursorError() error {
+	return NewAuthorError("invalid cursor")
+}
+
+func NewInvalidLinkError(link string) error {
+	return NewAuthorError("invalid link '" + link + "'")
 }
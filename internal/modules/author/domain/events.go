@@ -0,0 +1,48 @@
+package domain
+
+import "time"
+
+// Event names published by the author module
+const (
+	EventAuthorCreated = "author.created"
+	EventAuthorUpdated = "author.updated"
+	EventAuthorDeleted = "author.deleted"
+	EventAuthorsMerged = "author.merged"
+)
+
+// AuthorCreated is published after an author has been stored
+type AuthorCreated struct {
+	AuthorID  uint
+	Slug      string
+	CreatedAt time.Time
+}
+
+func (AuthorCreated) EventName() string { return EventAuthorCreated }
+
+// AuthorUpdated is published after an author's profile has changed
+type AuthorUpdated struct {
+	AuthorID  uint
+	UpdatedAt time.Time
+}
+
+func (AuthorUpdated) EventName() string { return EventAuthorUpdated }
+
+// AuthorDeleted is published after an author has been removed
+type AuthorDeleted struct {
+	AuthorID uint
+	// ReassignedTo is the author that received the stories, if any
+	ReassignedTo uint
+	DeletedAt    time.Time
+}
+
+func (AuthorDeleted) EventName() string { return EventAuthorDeleted }
+
+// AuthorsMerged is published after a duplicate author has been merged into another one
+type AuthorsMerged struct {
+	SourceID uint
+	TargetID uint
+	Stories  int64
+	MergedAt time.Time
+}
+
+func (AuthorsMerged) EventName() string { return EventAuthorsMerged }
@@ -0,0 +1,116 @@
+package domain
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+)
+
+// ListSort is the order authors are listed in
+type ListSort string
+
+const (
+	SortByName       ListSort = "name"
+	SortByCreatedAt  ListSort = "createdAt"
+	SortByStoryCount ListSort = "storyCount"
+)
+
+const (
+	// DefaultListLimit is the page size used when no limit is given
+	DefaultListLimit = 20
+	// MaxListLimit caps the page size
+	MaxListLimit = 100
+)
+
+// ListCursor marks the last author of a page. Value holds the sort key of that
+// author, formatted for the sort the cursor was issued for.
+type ListCursor struct {
+	Sort  ListSort `json:"s"`
+	Desc  bool     `json:"d"`
+	Value string   `json:"v"`
+	ID    uint     `json:"i"`
+}
+
+// ListQuery describes a page of authors to list
+type ListQuery struct {
+	// Prefix matches the start of the first name, last name, full name or slug
+	Prefix string
+	Sort   ListSort
+	Desc   bool
+	After  *ListCursor
+	Limit  int
+}
+
+// AuthorPage is one page of listed authors. Next is nil on the last page.
+type AuthorPage struct {
+	Authors []*Author
+	Next    *ListCursor
+}
+
+// NewListQuery validates the raw listing parameters. Sort defaults to name
+// ascending; order defaults to ascending for name and descending otherwise.
+func NewListQuery(prefix, sort, order, cursor string, limit int) (*ListQuery, error) {
+	q := &ListQuery{
+		Prefix: strings.TrimSpace(prefix),
+		Sort:   ListSort(sort),
+		Limit:  limit,
+	}
+
+	switch q.Sort {
+	case "":
+		q.Sort = SortByName
+	case SortByName, SortByCreatedAt, SortByStoryCount:
+	default:
+		return nil, NewInvalidSortError(sort)
+	}
+
+	switch order {
+	case "":
+		q.Desc = q.Sort != SortByName
+	case "asc":
+		q.Desc = false
+	case "desc":
+		q.Desc = true
+	default:
+		return nil, NewInvalidOrderError(order)
+	}
+
+	if q.Limit <= 0 {
+		q.Limit = DefaultListLimit
+	}
+	if q.Limit > MaxListLimit {
+		q.Limit = MaxListLimit
+	}
+
+	if cursor != "" {
+		after, err := DecodeListCursor(cursor)
+		if err != nil {
+			return nil, err
+		}
+		// A cursor only makes sense for the ordering it was issued for
+		if after.Sort != q.Sort || after.Desc != q.Desc {
+			return nil, NewInvalidCursorError()
+		}
+		q.After = after
+	}
+	return q, nil
+}
+
+// Encode returns the opaque form of the cursor handed to clients
+func (c *ListCursor) Encode() string {
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// DecodeListCursor parses a cursor produced by Encode
+func DecodeListCursor(cursor string) (*ListCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, NewInvalidCursorError()
+	}
+	var c ListCursor
+	if err := json.Unmarshal(raw, &c); err != nil || c.ID == 0 {
+		return nil, NewInvalidCursorError()
+	}
+	return &c, nil
+}
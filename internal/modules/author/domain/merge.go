@@ -0,0 +1,52 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// MergePlan describes what merging a duplicate author into another one moves.
+// A dry run returns the plan without applying it.
+type MergePlan struct {
+	SourceID   uint
+	TargetID   uint
+	SourceSlug string
+	// Stories is the number of stories reassigned from the source to the target
+	Stories int64
+	// Followers is the number of the source's followers moved to the target,
+	// leaving out users who already follow it
+	Followers int64
+	// RedirectSlugs are the source's current and previous slugs, which will
+	// resolve to the target after the merge
+	RedirectSlugs []string
+	DryRun        bool
+}
+
+// MergeRecord is the audit entry written for every applied merge
+type MergeRecord struct {
+	ID            uint
+	SourceID      uint
+	TargetID      uint
+	SourceSlug    string
+	Stories       int64
+	RedirectSlugs []string
+	MergedBy      string
+	MergedAt      time.Time
+}
+
+// ValidateMerge checks that two different authors are being merged
+func ValidateMerge(sourceID, targetID uint) error {
+	if sourceID == 0 || targetID == 0 {
+		return NewAuthorError("source and target authors are required")
+	}
+	if sourceID == targetID {
+		return NewAuthorError("an author cannot be merged into itself")
+	}
+	return nil
+}
+
+// MergedSlug is the placeholder slug a merged author keeps once its own slug
+// has become a redirect to the target
+func MergedSlug(sourceID, targetID uint) string {
+	return fmt.Sprintf("merged-%d-into-%d", sourceID, targetID)
+}
@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"strings"
+	"time"
+
+	"go-monolith/pkg/errors"
+)
+
+// maxUserIDLength matches the user ID columns used across modules
+const maxUserIDLength = 64
+
+// Ownership links an author persona to a user account allowed to manage it.
+// A user may own several authors and an author may have several owners.
+type Ownership struct {
+	AuthorID  uint
+	UserID    string
+	CreatedAt time.Time
+}
+
+func NewOwnership(authorID uint, userID string) (*Ownership, error) {
+	userID = strings.TrimSpace(userID)
+	if err := ValidateOwnerID(userID); err != nil {
+		return nil, err
+	}
+	return &Ownership{
+		AuthorID:  authorID,
+		UserID:    userID,
+		CreatedAt: time.Now(),
+	}, nil
+}
+
+// ValidateOwnerID checks that userID can be recorded as an author's owner
+func ValidateOwnerID(userID string) error {
+	if userID == "" || len(userID) > maxUserIDLength {
+		return NewAuthorError("invalid owner user ID")
+	}
+	return nil
+}
+
+func NewOwnershipNotFoundError(userID string) error {
+	return errors.NewNotFoundError("author owner", userID)
+}
+
+// NewLastOwnerError is returned when removing the only owner, which would leave the
+// author without anyone allowed to manage it
+func NewLastOwnerError(userID string) error {
+	return errors.NewConflictError("user " + userID + " is the author's last owner and cannot be removed")
+}
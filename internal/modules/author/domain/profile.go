@@ -0,0 +1,121 @@
+package domain
+
+import (
+	"net/url"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// MaxBioLength is the longest allowed bio, in characters
+	MaxBioLength = 1000
+	// MaxLocationLength is the longest allowed location, in characters
+	MaxLocationLength = 100
+	// MaxLinks is the maximum number of links on a profile
+	MaxLinks = 10
+)
+
+// LinkKind identifies the site a profile link points to
+type LinkKind string
+
+const (
+	LinkWebsite   LinkKind = "website"
+	LinkTwitter   LinkKind = "twitter"
+	LinkInstagram LinkKind = "instagram"
+	LinkFacebook  LinkKind = "facebook"
+	LinkLinkedIn  LinkKind = "linkedin"
+	LinkYouTube   LinkKind = "youtube"
+	LinkGitHub    LinkKind = "github"
+)
+
+// linkHosts lists the hosts accepted for each social link kind. Websites may use any host.
+var linkHosts = map[LinkKind][]string{
+	LinkTwitter:   {"twitter.com", "x.com"},
+	LinkInstagram: {"instagram.com"},
+	LinkFacebook:  {"facebook.com", "fb.com"},
+	LinkLinkedIn:  {"linkedin.com"},
+	LinkYouTube:   {"youtube.com", "youtu.be"},
+	LinkGitHub:    {"github.com"},
+}
+
+// Link is a website or social profile of an author
+type Link struct {
+	Kind LinkKind
+	URL  string
+}
+
+// UpdateProfile replaces the author's bio, location and links
+func (a *Author) UpdateProfile(bio, location string, links []Link) error {
+	bio = strings.TrimSpace(bio)
+	location = strings.TrimSpace(location)
+
+	if utf8.RuneCountInString(bio) > MaxBioLength {
+		return NewAuthorError("bio cannot exceed 1000 characters")
+	}
+	if utf8.RuneCountInString(location) > MaxLocationLength {
+		return NewAuthorError("location cannot exceed 100 characters")
+	}
+	if len(links) > MaxLinks {
+		return NewAuthorError("a profile cannot have more than 10 links")
+	}
+	for _, link := range links {
+		if err := ValidateLink(link); err != nil {
+			return err
+		}
+	}
+
+	a.Bio = bio
+	a.Location = location
+	a.Links = links
+	a.UpdatedAt = time.Now()
+	return nil
+}
+
+// Verify marks the author as verified by the given user
+func (a *Author) Verify(by string) error {
+	if by == "" {
+		return NewAuthorError("verifier cannot be empty")
+	}
+	if a.Verified {
+		return NewAuthorError("author is already verified")
+	}
+
+	now := time.Now()
+	a.Verified = true
+	a.VerifiedBy = by
+	a.VerifiedAt = &now
+	a.UpdatedAt = now
+	return nil
+}
+
+// Unverify removes the author's verification badge
+func (a *Author) Unverify() {
+	a.Verified = false
+	a.VerifiedBy = ""
+	a.VerifiedAt = nil
+	a.UpdatedAt = time.Now()
+}
+
+// ValidateLink checks that a link is an absolute http(s) URL on a host allowed for its kind
+func ValidateLink(link Link) error {
+	u, err := url.Parse(link.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" {
+		return NewInvalidLinkError(link.URL)
+	}
+
+	if link.Kind == LinkWebsite {
+		return nil
+	}
+	hosts, ok := linkHosts[link.Kind]
+	if !ok {
+		return NewAuthorError("invalid link kind '" + string(link.Kind) + "'")
+	}
+	host := strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+	for _, allowed := range hosts {
+		if host == allowed || strings.HasSuffix(host, "."+allowed) {
+			return nil
+		}
+	}
+	return NewInvalidLinkError(link.URL)
+}
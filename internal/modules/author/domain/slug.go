@@ -0,0 +1,126 @@
+package domain
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// MinSlugLength is the shortest allowed slug
+	MinSlugLength = 8
+	// MaxSlugLength is the longest allowed slug
+	MaxSlugLength = 255
+	// shortSlugSuffix pads generated slugs that would be too short
+	shortSlugSuffix = "-author"
+)
+
+// transliterations maps non-ASCII letters to their closest ASCII spelling
+var transliterations = map[rune]string{
+	// Latin
+	'à': "a", 'á': "a", 'â': "a", 'ã': "a", 'ä': "ae", 'å': "a", 'ā': "a", 'ă': "a", 'ą': "a",
+	'æ': "ae", 'ç': "c", 'ć': "c", 'č': "c", 'ĉ': "c", 'ċ': "c", 'ď': "d", 'đ': "d", 'ð': "d",
+	'è': "e", 'é': "e", 'ê': "e", 'ë': "e", 'ē': "e", 'ė': "e", 'ę': "e", 'ě': "e",
+	'ĝ': "g", 'ğ': "g", 'ġ': "g", 'ģ': "g", 'ĥ': "h", 'ħ': "h",
+	'ì': "i", 'í': "i", 'î': "i", 'ï': "i", 'ī': "i", 'į': "i", 'ı': "i",
+	'ĵ': "j", 'ķ': "k", 'ĺ': "l", 'ļ': "l", 'ľ': "l", 'ł': "l",
+	'ñ': "n", 'ń': "n", 'ņ': "n", 'ň': "n",
+	'ò': "o", 'ó': "o", 'ô': "o", 'õ': "o", 'ö': "oe", 'ø': "o", 'ō': "o", 'ő': "o", 'œ': "oe",
+	'ŕ': "r", 'ř': "r", 'ś': "s", 'ş': "s", 'š': "s", 'ș': "s", 'ß': "ss",
+	'ţ': "t", 'ť': "t", 'ț': "t", 'þ': "th",
+	'ù': "u", 'ú': "u", 'û': "u", 'ü': "ue", 'ū': "u", 'ů': "u", 'ű': "u", 'ų': "u",
+	'ý': "y", 'ÿ': "y", 'ź': "z", 'ż': "z", 'ž': "z",
+	// Cyrillic
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "yo", 'ж': "zh", 'з': "z",
+	'и': "i", 'й': "y", 'к': "k", 'л': "l", 'м': "m", 'н': "n", 'о': "o", 'п': "p", 'р': "r",
+	'с': "s", 'т': "t", 'у': "u", 'ф': "f", 'х': "kh", 'ц': "ts", 'ч': "ch", 'ш': "sh", 'щ': "shch",
+	'ъ': "", 'ы': "y", 'ь': "", 'э': "e", 'ю': "yu", 'я': "ya", 'і': "i", 'ї': "yi", 'є': "ye", 'ґ': "g",
+	// Greek
+	'α': "a", 'β': "v", 'γ': "g", 'δ': "d", 'ε': "e", 'ζ': "z", 'η': "i", 'θ': "th", 'ι': "i",
+	'κ': "k", 'λ': "l", 'μ': "m", 'ν': "n", 'ξ': "x", 'ο': "o", 'π': "p", 'ρ': "r", 'σ': "s",
+	'ς': "s", 'τ': "t", 'υ': "y", 'φ': "f", 'χ': "ch", 'ψ': "ps", 'ω': "o",
+	'ά': "a", 'έ': "e", 'ή': "i", 'ί': "i", 'ό': "o", 'ύ': "y", 'ώ': "o",
+}
+
+// Slugify transliterates s to lowercase ASCII and joins its words with hyphens
+func Slugify(s string) string {
+	var b strings.Builder
+	pendingHyphen := false
+	for _, r := range strings.ToLower(s) {
+		var part string
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			part = string(r)
+		default:
+			if t, ok := transliterations[r]; ok {
+				part = t
+			}
+		}
+		if part == "" {
+			// Anything else separates words
+			pendingHyphen = b.Len() > 0
+			continue
+		}
+		if pendingHyphen {
+			b.WriteByte('-')
+			pendingHyphen = false
+		}
+		b.WriteString(part)
+	}
+	return b.String()
+}
+
+// BaseSlug returns the slug generated from an author's name, before collision suffixes.
+// Slugs shorter than MinSlugLength are padded so they remain valid.
+func BaseSlug(firstName, lastName string) string {
+	slug := Slugify(firstName + " " + lastName)
+	if slug == "" {
+		slug = "author"
+	}
+	if len(slug) > MaxSlugLength-10 {
+		slug = strings.TrimRight(slug[:MaxSlugLength-10], "-")
+	}
+	if len(slug) < MinSlugLength {
+		slug += shortSlugSuffix
+	}
+	return slug
+}
+
+// SlugCandidate returns the n-th candidate for a base slug: the base itself
+// for n <= 1, otherwise the base with a numeric suffix ("jane-doe-2").
+func SlugCandidate(base string, n int) string {
+	if n <= 1 {
+		return base
+	}
+	return base + "-" + strconv.Itoa(n)
+}
+
+// ValidateSlug checks that a slug is lowercase ASCII letters, digits and single
+// hyphens, of an allowed length
+func ValidateSlug(slug string) error {
+	if len(slug) < MinSlugLength {
+		return NewInvalidSlugError()
+	}
+	if len(slug) > MaxSlugLength {
+		return NewAuthorError("slug cannot exceed 255 characters")
+	}
+	if Slugify(slug) != slug {
+		return NewAuthorError("slug may only contain lowercase letters, digits and single hyphens")
+	}
+	return nil
+}
+
+// ChangeSlug replaces the author's slug and returns the previous one
+func (a *Author) ChangeSlug(slug string) (string, error) {
+	if err := ValidateSlug(slug); err != nil {
+		return "", err
+	}
+	if slug == a.Slug {
+		return "", NewAuthorError("slug is unchanged")
+	}
+
+	previous := a.Slug
+	a.Slug = slug
+	a.UpdatedAt = time.Now()
+	return previous, nil
+}
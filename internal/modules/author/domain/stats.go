@@ -0,0 +1,20 @@
+package domain
+
+import "time"
+
+// Stats aggregates an author's stories. It is maintained incrementally from
+// story events and periodically reconciled against the stories themselves.
+type Stats struct {
+	AuthorID         uint
+	PublishedStories int64
+	TotalViews       int64
+	TotalLikes       int64
+	FirstPublishedAt *time.Time
+	LastPublishedAt  *time.Time
+	UpdatedAt        time.Time
+}
+
+// EmptyStats returns the aggregates of an author without stories
+func EmptyStats(authorID uint) *Stats {
+	return &Stats{AuthorID: authorID}
+}
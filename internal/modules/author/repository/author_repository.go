@@ -3,6 +3,9 @@ package repository
 import (
 	"context"
 	stderrors "errors"
+	"strconv"
+	"strings"
+	"time"
 
 	"gorm.io/gorm"
 
@@ -23,6 +26,17 @@ type authorModel struct {
 	Slug            string       `gorm:"type:varchar(255);not null;uniqueIndex"`
 	CreatedAt       sql.NullTime `gorm:"not null;default:CURRENT_TIMESTAMP"`
 	UpdatedAt       sql.NullTime `gorm:"not null;default:CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP"`
+	Bio             string       `gorm:"type:text"`
+	Location        string       `gorm:"type:varchar(255);not null;default:''"`
+	Links           []linkModel  `gorm:"type:json;serializer:json"`
+	Verified        bool         `gorm:"not null;default:false"`
+	VerifiedBy      string       `gorm:"type:varchar(64);not null;default:''"`
+	VerifiedAt      *time.Time
+	// DeletedAt is set when the author has been merged into another one
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+
+	// StoryCount is only selected by List
+	StoryCount int64 `gorm:"->;-:migration"`
 }
 
 // TableName sets the insert table name for this struct type
@@ -30,13 +44,38 @@ func (authorModel) TableName() string {
 	return "authors" // Use the correct table name
 }
 
+// linkModel is the JSON form of a profile link
+type linkModel struct {
+	Kind string `json:"kind"`
+	URL  string `json:"url"`
+}
+
+// slugHistoryModel keeps the previous slugs of an author so old links keep resolving
+type slugHistoryModel struct {
+	Slug      string    `gorm:"primaryKey;type:varchar(255)"`
+	AuthorID  uint      `gorm:"not null;index"`
+	ChangedAt time.Time `gorm:"not null;default:CURRENT_TIMESTAMP"`
+}
+
+// TableName sets the insert table name for this struct type
+func (slugHistoryModel) TableName() string {
+	return "author_slug_history"
+}
+
 // In this context, Only benefit of using interface is to allow for mocking in tests, otherwise not needed
 type AuthorRepository interface {
-	Create(ctx context.Context, author *domain.Author) error
+	Create(ctx context.Context, author *domain.Author, ownerID string) error
 	GetByID(ctx context.Context, id uint) (*domain.Author, error)
 	GetBySlug(ctx context.Context, slug string) (*domain.Author, error)
+	GetByIDs(ctx context.Context, ids []uint) ([]*domain.Author, error)
 	Update(ctx context.Context, author *domain.Author) error
-	Delete(ctx context.Context, id uint) error
+	Delete(ctx context.Context, id uint, soft bool) error
+	MissingIDs(ctx context.Context, ids []uint) ([]uint, error)
+	List(ctx context.Context, query *domain.ListQuery) (*domain.AuthorPage, error)
+	SlugExists(ctx context.Context, slug string) (bool, error)
+	ChangeSlug(ctx context.Context, author *domain.Author, previous string) error
+	PlanMerge(ctx context.Context, sourceID, targetID uint) (*domain.MergePlan, error)
+	Merge(ctx context.Context, sourceID, targetID uint, mergedBy string) (*domain.MergePlan, error)
 }
 
 type authorRepository struct {
@@ -47,11 +86,23 @@ func NewAuthorRepository(db *gorm.DB) AuthorRepository {
 	return &authorRepository{db: db}
 }
 
-func (r *authorRepository) Create(ctx context.Context, author *domain.Author) error {
+// Create stores the author together with its first owner in one transaction, so an
+// author never exists without someone allowed to manage it
+func (r *authorRepository) Create(ctx context.Context, author *domain.Author, ownerID string) error {
 	model := toModel(author)
-	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(model).Error; err != nil {
+			return err
+		}
+		return tx.Create(&ownershipModel{
+			AuthorID:  model.ID,
+			UserID:    ownerID,
+			CreatedAt: time.Now(),
+		}).Error
+	})
+	if err != nil {
 		if isDuplicateKeyError(err) {
-			return errors.NewValidationError("author with this slug already exists")
+			return domain.NewAuthorAlreadyExistsError(author.Slug)
 		}
 		if isTransientError(err) {
 			return errors.NewTransientError(err)
@@ -66,7 +117,7 @@ func (r *authorRepository) GetByID(ctx context.Context, id uint) (*domain.Author
 	var model authorModel
 	if err := r.db.WithContext(ctx).First(&model, id).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
-			return nil, errors.NewNotFoundError("author", string(id))
+			return nil, errors.NewNotFoundError("author", strconv.FormatUint(uint64(id), 10))
 		}
 		if err == gorm.ErrInvalidTransaction || err == gorm.ErrRegistered {
 			return nil, errors.NewTransientError(err)
@@ -76,11 +127,31 @@ func (r *authorRepository) GetByID(ctx context.Context, id uint) (*domain.Author
 	return toDomain(&model), nil
 }
 
+// GetByIDs returns the existing authors among ids, in no particular order
+func (r *authorRepository) GetByIDs(ctx context.Context, ids []uint) ([]*domain.Author, error) {
+	if len(ids) == 0 {
+		return []*domain.Author{}, nil
+	}
+
+	var models []*authorModel
+	if err := r.db.WithContext(ctx).Where("id IN ?", ids).Find(&models).Error; err != nil {
+		return nil, wrapRepositoryError(err)
+	}
+
+	authors := make([]*domain.Author, len(models))
+	for i, model := range models {
+		authors[i] = toDomain(model)
+	}
+	return authors, nil
+}
+
+// GetBySlug returns the author with the given current slug, or the author that
+// used it before a slug change
 func (r *authorRepository) GetBySlug(ctx context.Context, slug string) (*domain.Author, error) {
 	var model authorModel
 	if err := r.db.WithContext(ctx).Where("slug = ?", slug).First(&model).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
-			return nil, errors.NewNotFoundError("author", slug)
+			return r.getByPreviousSlug(ctx, slug)
 		}
 		if err == gorm.ErrInvalidTransaction || err == gorm.ErrRegistered {
 			return nil, errors.NewTransientError(err)
@@ -90,11 +161,96 @@ func (r *authorRepository) GetBySlug(ctx context.Context, slug string) (*domain.
 	return toDomain(&model), nil
 }
 
+func (r *authorRepository) getByPreviousSlug(ctx context.Context, slug string) (*domain.Author, error) {
+	var history slugHistoryModel
+	if err := r.db.WithContext(ctx).Where("slug = ?", slug).First(&history).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.NewNotFoundError("author", slug)
+		}
+		if isTransientError(err) {
+			return nil, errors.NewTransientError(err)
+		}
+		return nil, errors.NewUnexpectedError(err)
+	}
+
+	author, err := r.GetByID(ctx, history.AuthorID)
+	if err != nil {
+		if kind, ok := errors.KindOf(err); ok && kind == errors.ErrKindNotFound {
+			return nil, errors.NewNotFoundError("author", slug)
+		}
+		return nil, err
+	}
+	return author, nil
+}
+
+// SlugExists reports whether the slug is the current or a previous slug of any author
+func (r *authorRepository) SlugExists(ctx context.Context, slug string) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Raw("SELECT (SELECT COUNT(*) FROM authors WHERE slug = ?) + (SELECT COUNT(*) FROM author_slug_history WHERE slug = ?)", slug, slug).
+		Scan(&count).Error
+	if err != nil {
+		if isTransientError(err) {
+			return false, errors.NewTransientError(err)
+		}
+		return false, errors.NewUnexpectedError(err)
+	}
+	return count > 0, nil
+}
+
+// ChangeSlug stores the author's new slug and records the previous one in the history.
+// An author may take back one of its own previous slugs, but not another author's.
+func (r *authorRepository) ChangeSlug(ctx context.Context, author *domain.Author, previous string) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var owner slugHistoryModel
+		err := tx.Where("slug = ?", author.Slug).First(&owner).Error
+		switch {
+		case err == nil && owner.AuthorID != author.ID:
+			return domain.NewAuthorAlreadyExistsError(author.Slug)
+		case err == nil:
+			if err := tx.Delete(&owner).Error; err != nil {
+				return err
+			}
+		case err != gorm.ErrRecordNotFound:
+			return err
+		}
+
+		err = tx.Model(&authorModel{}).
+			Where("id = ?", author.ID).
+			Updates(map[string]interface{}{
+				"slug":       author.Slug,
+				"updated_at": author.UpdatedAt,
+			}).Error
+		if err != nil {
+			if isDuplicateKeyError(err) {
+				return domain.NewAuthorAlreadyExistsError(author.Slug)
+			}
+			return err
+		}
+
+		return tx.Create(&slugHistoryModel{
+			Slug:      previous,
+			AuthorID:  author.ID,
+			ChangedAt: author.UpdatedAt,
+		}).Error
+	})
+	if err != nil {
+		if _, ok := errors.KindOf(err); ok {
+			return err
+		}
+		if isTransientError(err) {
+			return errors.NewTransientError(err)
+		}
+		return errors.NewUnexpectedError(err)
+	}
+	return nil
+}
+
 func (r *authorRepository) Update(ctx context.Context, author *domain.Author) error {
 	model := toModel(author)
 	if err := r.db.WithContext(ctx).Save(model).Error; err != nil {
 		if isDuplicateKeyError(err) {
-			return errors.NewValidationError("author with this slug already exists")
+			return domain.NewAuthorAlreadyExistsError(author.Slug)
 		}
 		if isTransientError(err) {
 			return errors.NewTransientError(err)
@@ -104,8 +260,23 @@ func (r *authorRepository) Update(ctx context.Context, author *domain.Author) er
 	return nil
 }
 
-func (r *authorRepository) Delete(ctx context.Context, id uint) error {
-	if err := r.db.WithContext(ctx).Delete(&authorModel{}, id).Error; err != nil {
+// Delete removes the author together with its slug history and owners. The author's
+// stories must have been dealt with by the story module first; soft deletion keeps
+// the author's record for stories that were soft-deleted along with it.
+func (r *authorRepository) Delete(ctx context.Context, id uint, soft bool) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("author_id = ?", id).Delete(&slugHistoryModel{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("author_id = ?", id).Delete(&ownershipModel{}).Error; err != nil {
+			return err
+		}
+		if soft {
+			return tx.Delete(&authorModel{}, id).Error
+		}
+		return tx.Unscoped().Delete(&authorModel{}, id).Error
+	})
+	if err != nil {
 		if isTransientError(err) {
 			return errors.NewTransientError(err)
 		}
@@ -114,6 +285,145 @@ func (r *authorRepository) Delete(ctx context.Context, id uint) error {
 	return nil
 }
 
+// MissingIDs returns the IDs that do not belong to an existing author
+func (r *authorRepository) MissingIDs(ctx context.Context, ids []uint) ([]uint, error) {
+	if len(ids) == 0 {
+		return []uint{}, nil
+	}
+
+	var existing []uint
+	err := r.db.WithContext(ctx).
+		Model(&authorModel{}).
+		Where("id IN ?", ids).
+		Pluck("id", &existing).Error
+	if err != nil {
+		if isTransientError(err) {
+			return nil, errors.NewTransientError(err)
+		}
+		return nil, errors.NewUnexpectedError(err)
+	}
+
+	found := make(map[uint]bool, len(existing))
+	for _, id := range existing {
+		found[id] = true
+	}
+	missing := []uint{}
+	for _, id := range ids {
+		if !found[id] {
+			missing = append(missing, id)
+		}
+	}
+	return missing, nil
+}
+
+// Sort key expressions used by List. The story count is read from the story module's table.
+const (
+	nameExpr       = "CONCAT(authors.first_name, ' ', authors.last_name)"
+	storyCountExpr = "(SELECT COUNT(*) FROM stories WHERE stories.author_id = authors.id AND stories.deleted_at IS NULL)"
+)
+
+// List returns a page of authors matching the query, using keyset pagination on the
+// sort key and ID so pages stay stable while authors are added.
+func (r *authorRepository) List(ctx context.Context, query *domain.ListQuery) (*domain.AuthorPage, error) {
+	sortExpr := nameExpr
+	switch query.Sort {
+	case domain.SortByCreatedAt:
+		sortExpr = "authors.created_at"
+	case domain.SortByStoryCount:
+		sortExpr = storyCountExpr
+	}
+	direction, cmp := "ASC", ">"
+	if query.Desc {
+		direction, cmp = "DESC", "<"
+	}
+
+	db := r.db.WithContext(ctx).
+		Model(&authorModel{}).
+		Select("authors.*, " + storyCountExpr + " AS story_count")
+
+	if query.Prefix != "" {
+		like := escapeLike(query.Prefix) + "%"
+		db = db.Where("authors.first_name LIKE ? OR authors.last_name LIKE ? OR "+nameExpr+" LIKE ? OR authors.slug LIKE ?",
+			like, like, like, like)
+	}
+
+	if query.After != nil {
+		value, err := cursorValue(query.Sort, query.After.Value)
+		if err != nil {
+			return nil, err
+		}
+		db = db.Where("("+sortExpr+" "+cmp+" ?) OR ("+sortExpr+" = ? AND authors.id "+cmp+" ?)",
+			value, value, query.After.ID)
+	}
+
+	// One extra row tells whether another page follows
+	var models []*authorModel
+	err := db.
+		Order(sortExpr + " " + direction).
+		Order("authors.id " + direction).
+		Limit(query.Limit + 1).
+		Find(&models).Error
+	if err != nil {
+		if isTransientError(err) {
+			return nil, errors.NewTransientError(err)
+		}
+		return nil, errors.NewUnexpectedError(err)
+	}
+
+	page := &domain.AuthorPage{}
+	if len(models) > query.Limit {
+		models = models[:query.Limit]
+		last := models[len(models)-1]
+		page.Next = &domain.ListCursor{
+			Sort:  query.Sort,
+			Desc:  query.Desc,
+			Value: sortValue(query.Sort, last),
+			ID:    last.ID,
+		}
+	}
+
+	page.Authors = make([]*domain.Author, len(models))
+	for i, model := range models {
+		page.Authors[i] = toDomain(model)
+	}
+	return page, nil
+}
+
+// sortValue formats the sort key of an author for a cursor
+func sortValue(sort domain.ListSort, model *authorModel) string {
+	switch sort {
+	case domain.SortByCreatedAt:
+		return model.CreatedAt.Time.UTC().Format(time.RFC3339Nano)
+	case domain.SortByStoryCount:
+		return strconv.FormatInt(model.StoryCount, 10)
+	}
+	return model.FirstName + " " + model.LastName
+}
+
+// cursorValue parses the sort key stored in a cursor
+func cursorValue(sort domain.ListSort, value string) (interface{}, error) {
+	switch sort {
+	case domain.SortByCreatedAt:
+		t, err := time.Parse(time.RFC3339Nano, value)
+		if err != nil {
+			return nil, domain.NewInvalidCursorError()
+		}
+		return t, nil
+	case domain.SortByStoryCount:
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, domain.NewInvalidCursorError()
+		}
+		return n, nil
+	}
+	return value, nil
+}
+
+// escapeLike escapes the LIKE wildcards in a user-supplied prefix
+func escapeLike(s string) string {
+	return strings.NewReplacer("\\", "\\\\", "%", "\\%", "_", "\\_").Replace(s)
+}
+
 // toModel converts domain author to database model
 func toModel(author *domain.Author) *authorModel {
 	return &authorModel{
@@ -124,6 +434,12 @@ func toModel(author *domain.Author) *authorModel {
 		Slug:            author.Slug,
 		CreatedAt:       sql.NullTime{Time: author.CreatedAt, Valid: !author.CreatedAt.IsZero()},
 		UpdatedAt:       sql.NullTime{Time: author.UpdatedAt, Valid: !author.UpdatedAt.IsZero()},
+		Bio:             author.Bio,
+		Location:        author.Location,
+		Links:           toLinkModels(author.Links),
+		Verified:        author.Verified,
+		VerifiedBy:      author.VerifiedBy,
+		VerifiedAt:      author.VerifiedAt,
 	}
 }
 
@@ -137,7 +453,41 @@ func toDomain(model *authorModel) *domain.Author {
 		Slug:            model.Slug,
 		CreatedAt:       model.CreatedAt.Time,
 		UpdatedAt:       model.UpdatedAt.Time,
+		Bio:             model.Bio,
+		Location:        model.Location,
+		Links:           toDomainLinks(model.Links),
+		Verified:        model.Verified,
+		VerifiedBy:      model.VerifiedBy,
+		VerifiedAt:      model.VerifiedAt,
+		StoryCount:      model.StoryCount,
+	}
+}
+
+func toLinkModels(links []domain.Link) []linkModel {
+	models := make([]linkModel, len(links))
+	for i, link := range links {
+		models[i] = linkModel{Kind: string(link.Kind), URL: link.URL}
+	}
+	return models
+}
+
+func toDomainLinks(models []linkModel) []domain.Link {
+	links := make([]domain.Link, len(models))
+	for i, model := range models {
+		links[i] = domain.Link{Kind: domain.LinkKind(model.Kind), URL: model.URL}
+	}
+	return links
+}
+
+// wrapRepositoryError keeps domain errors and classifies database errors
+func wrapRepositoryError(err error) error {
+	if _, ok := errors.KindOf(err); ok {
+		return err
+	}
+	if isTransientError(err) {
+		return errors.NewTransientError(err)
 	}
+	return errors.NewUnexpectedError(err)
 }
 
 func isDuplicateKeyError(err error) bool {
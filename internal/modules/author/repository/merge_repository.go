@@ -0,0 +1,186 @@
+package repository
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"go-monolith/internal/modules/author/domain"
+	"go-monolith/pkg/errors"
+)
+
+// mergeModel is the audit record of an applied author merge
+type mergeModel struct {
+	ID            uint      `gorm:"primaryKey;autoIncrement"`
+	SourceID      uint      `gorm:"not null;index"`
+	TargetID      uint      `gorm:"not null;index"`
+	SourceSlug    string    `gorm:"type:varchar(255);not null"`
+	Stories       int64     `gorm:"not null"`
+	RedirectSlugs []string  `gorm:"type:json;serializer:json"`
+	MergedBy      string    `gorm:"type:varchar(64);not null"`
+	MergedAt      time.Time `gorm:"not null"`
+}
+
+// TableName sets the insert table name for this struct type
+func (mergeModel) TableName() string {
+	return "author_merges"
+}
+
+// PlanMerge reports what merging source into target would move, without changing anything
+func (r *authorRepository) PlanMerge(ctx context.Context, sourceID, targetID uint) (*domain.MergePlan, error) {
+	plan, err := planMerge(r.db.WithContext(ctx), sourceID, targetID)
+	if err != nil {
+		return nil, wrapRepositoryError(err)
+	}
+	plan.DryRun = true
+	return plan, nil
+}
+
+// Merge moves the source's stories and followers to the target, turns the source's
+// slugs into redirects to the target, soft-deletes the source and records an audit
+// entry, all in one transaction
+func (r *authorRepository) Merge(ctx context.Context, sourceID, targetID uint, mergedBy string) (*domain.MergePlan, error) {
+	var plan *domain.MergePlan
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Lock both authors in ID order so concurrent merges cannot deadlock
+		var locked []authorModel
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id IN ?", []uint{sourceID, targetID}).
+			Order("id").
+			Find(&locked).Error
+		if err != nil {
+			return err
+		}
+
+		plan, err = planMerge(tx, sourceID, targetID)
+		if err != nil {
+			return err
+		}
+		now := time.Now()
+
+		// The story module's table is updated in place, like the story count in List
+		err = tx.Exec("UPDATE stories SET author_id = ? WHERE author_id = ?", targetID, sourceID).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Model(&slugHistoryModel{}).
+			Where("author_id = ?", sourceID).
+			Update("author_id", targetID).Error
+		if err != nil {
+			return err
+		}
+
+		// Owners of the duplicate keep managing the merged author
+		err = tx.Exec("INSERT IGNORE INTO author_owners (author_id, user_id, created_at) SELECT ?, user_id, created_at FROM author_owners WHERE author_id = ?",
+			targetID, sourceID).Error
+		if err != nil {
+			return err
+		}
+		if err := tx.Where("author_id = ?", sourceID).Delete(&ownershipModel{}).Error; err != nil {
+			return err
+		}
+
+		// Followers move to the target as well; users who already follow it keep their
+		// original follow and the duplicate is dropped
+		err = tx.Exec("INSERT IGNORE INTO author_follows (user_id, author_id, created_at) SELECT user_id, ?, created_at FROM author_follows WHERE author_id = ?",
+			targetID, sourceID).Error
+		if err != nil {
+			return err
+		}
+		if err := tx.Exec("DELETE FROM author_follows WHERE author_id = ?", sourceID).Error; err != nil {
+			return err
+		}
+
+		// Free the source's slug before it becomes a redirect to the target
+		err = tx.Model(&authorModel{}).
+			Where("id = ?", sourceID).
+			Updates(map[string]interface{}{
+				"slug":       domain.MergedSlug(sourceID, targetID),
+				"updated_at": now,
+			}).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Create(&slugHistoryModel{
+			Slug:      plan.SourceSlug,
+			AuthorID:  targetID,
+			ChangedAt: now,
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		if err := tx.Delete(&authorModel{}, sourceID).Error; err != nil {
+			return err
+		}
+
+		return tx.Create(&mergeModel{
+			SourceID:      sourceID,
+			TargetID:      targetID,
+			SourceSlug:    plan.SourceSlug,
+			Stories:       plan.Stories,
+			RedirectSlugs: plan.RedirectSlugs,
+			MergedBy:      mergedBy,
+			MergedAt:      now,
+		}).Error
+	})
+	if err != nil {
+		return nil, wrapRepositoryError(err)
+	}
+	return plan, nil
+}
+
+// planMerge loads both authors and counts what belongs to the source
+func planMerge(db *gorm.DB, sourceID, targetID uint) (*domain.MergePlan, error) {
+	var source, target authorModel
+	if err := db.First(&source, sourceID).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.NewNotFoundError("author", strconv.FormatUint(uint64(sourceID), 10))
+		}
+		return nil, err
+	}
+	if err := db.First(&target, targetID).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.NewNotFoundError("author", strconv.FormatUint(uint64(targetID), 10))
+		}
+		return nil, err
+	}
+
+	plan := &domain.MergePlan{
+		SourceID:      sourceID,
+		TargetID:      targetID,
+		SourceSlug:    source.Slug,
+		RedirectSlugs: []string{source.Slug},
+	}
+
+	err := db.Raw("SELECT COUNT(*) FROM stories WHERE author_id = ? AND deleted_at IS NULL", sourceID).
+		Scan(&plan.Stories).Error
+	if err != nil {
+		return nil, err
+	}
+
+	// Followers of both authors are not counted, their follow of the target is kept
+	err = db.Raw("SELECT COUNT(*) FROM author_follows f WHERE f.author_id = ? AND NOT EXISTS (SELECT 1 FROM author_follows t WHERE t.author_id = ? AND t.user_id = f.user_id)",
+		sourceID, targetID).
+		Scan(&plan.Followers).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var previous []string
+	err = db.Model(&slugHistoryModel{}).
+		Where("author_id = ?", sourceID).
+		Order("changed_at").
+		Pluck("slug", &previous).Error
+	if err != nil {
+		return nil, err
+	}
+	plan.RedirectSlugs = append(plan.RedirectSlugs, previous...)
+
+	return plan, nil
+}
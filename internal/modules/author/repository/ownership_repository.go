@@ -0,0 +1,147 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"go-monolith/internal/modules/author/domain"
+)
+
+// ownershipModel links an author to a user account that manages it
+type ownershipModel struct {
+	AuthorID  uint      `gorm:"primaryKey"`
+	UserID    string    `gorm:"primaryKey;type:varchar(64);index"`
+	CreatedAt time.Time `gorm:"not null;default:CURRENT_TIMESTAMP"`
+}
+
+// TableName sets the insert table name for this struct type
+func (ownershipModel) TableName() string {
+	return "author_owners"
+}
+
+// OwnershipRepository stores which users manage which authors
+type OwnershipRepository interface {
+	Add(ctx context.Context, ownership *domain.Ownership) error
+	Remove(ctx context.Context, authorID uint, userID string) error
+	IsOwner(ctx context.Context, authorID uint, userID string) (bool, error)
+	ListOwnerIDs(ctx context.Context, authorID uint) ([]string, error)
+	ListAuthorsByOwner(ctx context.Context, userID string) ([]*domain.Author, error)
+	ListUnownedAuthorIDs(ctx context.Context) ([]uint, error)
+}
+
+type ownershipRepository struct {
+	db *gorm.DB
+}
+
+func NewOwnershipRepository(db *gorm.DB) OwnershipRepository {
+	return &ownershipRepository{db: db}
+}
+
+// Add links the user to the author. Adding an existing owner is a no-op.
+func (r *ownershipRepository) Add(ctx context.Context, ownership *domain.Ownership) error {
+	err := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&ownershipModel{
+			AuthorID:  ownership.AuthorID,
+			UserID:    ownership.UserID,
+			CreatedAt: ownership.CreatedAt,
+		}).Error
+	if err != nil {
+		return wrapRepositoryError(err)
+	}
+	return nil
+}
+
+// Remove unlinks the user from the author, refusing to remove the author's last owner.
+// The author's owners are locked so concurrent removals cannot leave it without one.
+func (r *ownershipRepository) Remove(ctx context.Context, authorID uint, userID string) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var owners []string
+		err := tx.Model(&ownershipModel{}).
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("author_id = ?", authorID).
+			Pluck("user_id", &owners).Error
+		if err != nil {
+			return err
+		}
+
+		found := false
+		for _, owner := range owners {
+			found = found || owner == userID
+		}
+		if !found {
+			return domain.NewOwnershipNotFoundError(userID)
+		}
+		if len(owners) == 1 {
+			return domain.NewLastOwnerError(userID)
+		}
+		return tx.Where("author_id = ? AND user_id = ?", authorID, userID).
+			Delete(&ownershipModel{}).Error
+	})
+	if err != nil {
+		return wrapRepositoryError(err)
+	}
+	return nil
+}
+
+func (r *ownershipRepository) IsOwner(ctx context.Context, authorID uint, userID string) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&ownershipModel{}).
+		Where("author_id = ? AND user_id = ?", authorID, userID).
+		Count(&count).Error
+	if err != nil {
+		return false, wrapRepositoryError(err)
+	}
+	return count > 0, nil
+}
+
+func (r *ownershipRepository) ListOwnerIDs(ctx context.Context, authorID uint) ([]string, error) {
+	var userIDs []string
+	err := r.db.WithContext(ctx).
+		Model(&ownershipModel{}).
+		Where("author_id = ?", authorID).
+		Order("created_at").
+		Pluck("user_id", &userIDs).Error
+	if err != nil {
+		return nil, wrapRepositoryError(err)
+	}
+	return userIDs, nil
+}
+
+// ListUnownedAuthorIDs returns the IDs of the authors nobody manages, such as those
+// created before authors had owners
+func (r *ownershipRepository) ListUnownedAuthorIDs(ctx context.Context) ([]uint, error) {
+	var ids []uint
+	err := r.db.WithContext(ctx).
+		Model(&authorModel{}).
+		Where("NOT EXISTS (SELECT 1 FROM author_owners WHERE author_owners.author_id = authors.id)").
+		Order("id").
+		Pluck("id", &ids).Error
+	if err != nil {
+		return nil, wrapRepositoryError(err)
+	}
+	return ids, nil
+}
+
+// ListAuthorsByOwner returns the authors managed by the user, oldest link first
+func (r *ownershipRepository) ListAuthorsByOwner(ctx context.Context, userID string) ([]*domain.Author, error) {
+	var models []*authorModel
+	err := r.db.WithContext(ctx).
+		Joins("JOIN author_owners ON author_owners.author_id = authors.id").
+		Where("author_owners.user_id = ?", userID).
+		Order("author_owners.created_at, authors.id").
+		Find(&models).Error
+	if err != nil {
+		return nil, wrapRepositoryError(err)
+	}
+
+	authors := make([]*domain.Author, len(models))
+	for i, model := range models {
+		authors[i] = toDomain(model)
+	}
+	return authors, nil
+}
@@ -0,0 +1,283 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"go-monolith/internal/modules/author/domain"
+	"go-monolith/pkg/errors"
+)
+
+// statsModel represents the database model of an author's aggregates
+type statsModel struct {
+	AuthorID         uint  `gorm:"primaryKey"`
+	PublishedStories int64 `gorm:"not null;default:0"`
+	TotalViews       int64 `gorm:"not null;default:0"`
+	TotalLikes       int64 `gorm:"not null;default:0"`
+	FirstPublishedAt *time.Time
+	LastPublishedAt  *time.Time
+	UpdatedAt        time.Time `gorm:"not null"`
+}
+
+// TableName sets the insert table name for this struct type
+func (statsModel) TableName() string {
+	return "author_stats"
+}
+
+// StatsRepository stores the per-author aggregates
+type StatsRepository interface {
+	Get(ctx context.Context, authorID uint) (*domain.Stats, error)
+	GetMany(ctx context.Context, authorIDs []uint) (map[uint]*domain.Stats, error)
+	AddPublished(ctx context.Context, authorID uint, publishedAt time.Time) error
+	AddLike(ctx context.Context, authorID uint) error
+	AddViews(ctx context.Context, authorID uint, views int64) error
+	RemoveStory(ctx context.Context, authorID uint, publishedAt *time.Time, views, likes int64) error
+	Delete(ctx context.Context, authorID uint) error
+	Rebuild(ctx context.Context) (int64, error)
+	RebuildAuthor(ctx context.Context, authorID uint) error
+}
+
+type statsRepository struct {
+	db *gorm.DB
+}
+
+func NewStatsRepository(db *gorm.DB) StatsRepository {
+	return &statsRepository{db: db}
+}
+
+// Get returns the author's aggregates, which are empty if nothing was recorded yet
+func (r *statsRepository) Get(ctx context.Context, authorID uint) (*domain.Stats, error) {
+	var model statsModel
+	if err := r.db.WithContext(ctx).First(&model, authorID).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return domain.EmptyStats(authorID), nil
+		}
+		return nil, wrapStatsError(err)
+	}
+	return toStatsDomain(&model), nil
+}
+
+// GetMany returns the aggregates of several authors, including empty ones
+func (r *statsRepository) GetMany(ctx context.Context, authorIDs []uint) (map[uint]*domain.Stats, error) {
+	stats := make(map[uint]*domain.Stats, len(authorIDs))
+	if len(authorIDs) == 0 {
+		return stats, nil
+	}
+
+	var models []*statsModel
+	if err := r.db.WithContext(ctx).Where("author_id IN ?", authorIDs).Find(&models).Error; err != nil {
+		return nil, wrapStatsError(err)
+	}
+	for _, model := range models {
+		stats[model.AuthorID] = toStatsDomain(model)
+	}
+	for _, id := range authorIDs {
+		if _, ok := stats[id]; !ok {
+			stats[id] = domain.EmptyStats(id)
+		}
+	}
+	return stats, nil
+}
+
+// AddPublished counts a publication and moves the publish dates
+func (r *statsRepository) AddPublished(ctx context.Context, authorID uint, publishedAt time.Time) error {
+	return r.upsert(ctx, &statsModel{
+		AuthorID:         authorID,
+		PublishedStories: 1,
+		FirstPublishedAt: &publishedAt,
+		LastPublishedAt:  &publishedAt,
+		UpdatedAt:        time.Now(),
+	}, map[string]interface{}{
+		"published_stories":  gorm.Expr("published_stories + 1"),
+		"first_published_at": gorm.Expr("COALESCE(LEAST(first_published_at, ?), ?)", publishedAt, publishedAt),
+		"last_published_at":  gorm.Expr("COALESCE(GREATEST(last_published_at, ?), ?)", publishedAt, publishedAt),
+		"updated_at":         time.Now(),
+	})
+}
+
+// AddLike adds a like to the author's totals
+func (r *statsRepository) AddLike(ctx context.Context, authorID uint) error {
+	return r.upsert(ctx, &statsModel{
+		AuthorID:   authorID,
+		TotalLikes: 1,
+		UpdatedAt:  time.Now(),
+	}, map[string]interface{}{
+		"total_likes": gorm.Expr("total_likes + 1"),
+		"updated_at":  time.Now(),
+	})
+}
+
+// AddViews adds compacted views to the author's totals
+func (r *statsRepository) AddViews(ctx context.Context, authorID uint, views int64) error {
+	return r.upsert(ctx, &statsModel{
+		AuthorID:   authorID,
+		TotalViews: views,
+		UpdatedAt:  time.Now(),
+	}, map[string]interface{}{
+		"total_views": gorm.Expr("total_views + ?", views),
+		"updated_at":  time.Now(),
+	})
+}
+
+// RemoveStory subtracts a deleted story from the totals. When the story held the
+// first or latest publish date, the dates are recomputed from the remaining stories.
+func (r *statsRepository) RemoveStory(ctx context.Context, authorID uint, publishedAt *time.Time, views, likes int64) error {
+	var published int64
+	if publishedAt != nil {
+		published = 1
+	}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&statsModel{}).
+			Where("author_id = ?", authorID).
+			Updates(map[string]interface{}{
+				"published_stories": gorm.Expr("GREATEST(published_stories - ?, 0)", published),
+				"total_views":       gorm.Expr("GREATEST(total_views - ?, 0)", views),
+				"total_likes":       gorm.Expr("GREATEST(total_likes - ?, 0)", likes),
+				"updated_at":        time.Now(),
+			}).Error
+		if err != nil || publishedAt == nil {
+			return err
+		}
+
+		var dates struct {
+			First sql.NullTime
+			Last  sql.NullTime
+		}
+		err = tx.Raw("SELECT MIN(published_at) AS first, MAX(published_at) AS last FROM stories WHERE author_id = ? AND published_at IS NOT NULL AND deleted_at IS NULL", authorID).
+			Scan(&dates).Error
+		if err != nil {
+			return err
+		}
+		return tx.Model(&statsModel{}).
+			Where("author_id = ?", authorID).
+			Updates(map[string]interface{}{
+				"first_published_at": nullTimePtr(dates.First),
+				"last_published_at":  nullTimePtr(dates.Last),
+			}).Error
+	})
+	if err != nil {
+		return wrapStatsError(err)
+	}
+	return nil
+}
+
+func (r *statsRepository) Delete(ctx context.Context, authorID uint) error {
+	if err := r.db.WithContext(ctx).Delete(&statsModel{}, authorID).Error; err != nil {
+		return wrapStatsError(err)
+	}
+	return nil
+}
+
+// Rebuild recomputes the aggregates of every author from the stories table,
+// correcting any drift from missed events, and returns the number of rows written.
+// Authors without live stories are reset, as there is nothing to rebuild them from.
+func (r *statsRepository) Rebuild(ctx context.Context) (int64, error) {
+	var rows int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		reset := tx.Exec(`UPDATE author_stats
+			SET published_stories = 0, total_views = 0, total_likes = 0,
+				first_published_at = NULL, last_published_at = NULL, updated_at = ?
+			WHERE author_id NOT IN (SELECT author_id FROM stories WHERE deleted_at IS NULL)`, time.Now())
+		if reset.Error != nil {
+			return reset.Error
+		}
+
+		rebuilt, err := rebuildStats(tx, "")
+		rows = reset.RowsAffected + rebuilt
+		return err
+	})
+	if err != nil {
+		return 0, wrapStatsError(err)
+	}
+	return rows, nil
+}
+
+// RebuildAuthor recomputes the aggregates of one author from the stories table
+func (r *statsRepository) RebuildAuthor(ctx context.Context, authorID uint) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Authors without stories have no row to rebuild from
+		if err := tx.Delete(&statsModel{}, authorID).Error; err != nil {
+			return err
+		}
+		_, err := rebuildStats(tx, "AND author_id = ?", authorID)
+		return err
+	})
+	if err != nil {
+		return wrapStatsError(err)
+	}
+	return nil
+}
+
+// rebuildStats writes the aggregates computed from the live stories matching the
+// extra condition. Story view counters only include compacted views, like the
+// author totals, so views still waiting for compaction are left out of both.
+func rebuildStats(db *gorm.DB, condition string, args ...interface{}) (int64, error) {
+	result := db.Exec(`
+		INSERT INTO author_stats (author_id, published_stories, total_views, total_likes, first_published_at, last_published_at, updated_at)
+		SELECT author_id,
+			SUM(published_at IS NOT NULL),
+			SUM(views),
+			SUM(likes),
+			MIN(published_at),
+			MAX(published_at),
+			?
+		FROM stories
+		WHERE deleted_at IS NULL `+condition+`
+		GROUP BY author_id
+		ON DUPLICATE KEY UPDATE
+			published_stories = VALUES(published_stories),
+			total_views = VALUES(total_views),
+			total_likes = VALUES(total_likes),
+			first_published_at = VALUES(first_published_at),
+			last_published_at = VALUES(last_published_at),
+			updated_at = VALUES(updated_at)`, append([]interface{}{time.Now()}, args...)...)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return result.RowsAffected, nil
+}
+
+// upsert inserts the initial row of an author or applies the updates to the existing one
+func (r *statsRepository) upsert(ctx context.Context, initial *statsModel, updates map[string]interface{}) error {
+	err := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "author_id"}},
+			DoUpdates: clause.Assignments(updates),
+		}).
+		Create(initial).Error
+	if err != nil {
+		return wrapStatsError(err)
+	}
+	return nil
+}
+
+func toStatsDomain(model *statsModel) *domain.Stats {
+	return &domain.Stats{
+		AuthorID:         model.AuthorID,
+		PublishedStories: model.PublishedStories,
+		TotalViews:       model.TotalViews,
+		TotalLikes:       model.TotalLikes,
+		FirstPublishedAt: model.FirstPublishedAt,
+		LastPublishedAt:  model.LastPublishedAt,
+		UpdatedAt:        model.UpdatedAt,
+	}
+}
+
+func nullTimePtr(t sql.NullTime) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
+
+func wrapStatsError(err error) error {
+	if isTransientError(err) {
+		return errors.NewTransientError(err)
+	}
+	return errors.NewUnexpectedError(err)
+}
@@ -9,27 +9,79 @@ import (
 	"go-monolith/internal/modules/author/domain"
 	"go-monolith/internal/modules/author/repository"
 	"go-monolith/pkg/errors"
+	"go-monolith/pkg/events"
 	"go-monolith/pkg/logger"
 	"go-monolith/pkg/metrics"
 )
 
+// maxSlugCandidates bounds the collision suffixes tried when generating a slug
+const maxSlugCandidates = 100
+
+// StoryCatalog is the author module's port to the story module, which owns the
+// stories that the delete policy acts on
+type StoryCatalog interface {
+	// CountByAuthor returns the number of stories written by the author
+	CountByAuthor(ctx context.Context, authorID uint) (int64, error)
+	// ReassignAuthor moves the author's stories to another author
+	ReassignAuthor(ctx context.Context, fromID, toID uint) (int, error)
+	// DeleteByAuthor deletes the author's stories
+	DeleteByAuthor(ctx context.Context, authorID uint) (int, error)
+}
+
 type AuthorService struct {
-	repo    repository.AuthorRepository
-	logger  logger.Logger
-	metrics *metrics.Client
+	repo         repository.AuthorRepository
+	stories      StoryCatalog
+	deletePolicy domain.DeletePolicy
+	events       *events.Bus
+	logger       logger.Logger
+	metrics      *metrics.Client
 }
 
-func NewAuthorService(repo repository.AuthorRepository, logger logger.Logger, metrics *metrics.Client) *AuthorService {
+func NewAuthorService(repo repository.AuthorRepository, stories StoryCatalog, deletePolicy domain.DeletePolicy, bus *events.Bus, logger logger.Logger, metrics *metrics.Client) *AuthorService {
 	return &AuthorService{
-		repo:    repo,
-		logger:  logger,
-		metrics: metrics,
+		repo:         repo,
+		stories:      stories,
+		deletePolicy: deletePolicy,
+		events:       bus,
+		logger:       logger,
+		metrics:      metrics,
 	}
 }
 
 // Write Operations (Commands)
-func (s *AuthorService) Create(ctx context.Context, firstName, lastName, profileImageURL, slug string) (*domain.Author, error) {
+
+// Create stores a new author. An empty slug is generated from the author's name.
+func (s *AuthorService) Create(ctx context.Context, firstName, lastName, profileImageURL, slug, ownerID string) (*domain.Author, error) {
 	start := time.Now()
+	if err := domain.ValidateOwnerID(ownerID); err != nil {
+		s.metrics.IncrementCounter("author.create.error", []string{
+			"error_type:validation",
+		})
+		return nil, err
+	}
+	if slug == "" {
+		generated, err := s.GenerateSlug(ctx, firstName, lastName)
+		if err != nil {
+			s.metrics.IncrementCounter("author.create.error", []string{
+				"error_type:slug",
+			})
+			return nil, err
+		}
+		slug = generated
+	} else if err := domain.ValidateSlug(slug); err != nil {
+		s.metrics.IncrementCounter("author.create.error", []string{
+			"slug:" + slug,
+			"error_type:validation",
+		})
+		return nil, err
+	} else if err := s.checkSlugAvailable(ctx, slug); err != nil {
+		s.metrics.IncrementCounter("author.create.error", []string{
+			"slug:" + slug,
+			"error_type:slug",
+		})
+		return nil, err
+	}
+
 	s.logger.Info(ctx, "Creating new author",
 		logger.String("first_name", firstName),
 		logger.String("last_name", lastName),
@@ -55,10 +107,14 @@ func (s *AuthorService) Create(ctx context.Context, firstName, lastName, profile
 		return nil, err
 	}
 
-	if err := s.repo.Create(ctx, author); err != nil {
+	if err := s.repo.Create(ctx, author, ownerID); err != nil {
 		var baseErr *errors.BaseError
 		if stderrors.As(err, &baseErr) && baseErr.Kind == errors.ErrKindTransient {
-			return s.retryCreate(ctx, author)
+			author, err = s.retryCreate(ctx, author, ownerID)
+			if err == nil {
+				s.publishCreated(ctx, author)
+			}
+			return author, err
 		}
 		s.logger.Error(ctx, "Failed to save author to repository",
 			logger.String("error", err.Error()),
@@ -71,6 +127,8 @@ func (s *AuthorService) Create(ctx context.Context, firstName, lastName, profile
 		return nil, err
 	}
 
+	s.publishCreated(ctx, author)
+
 	s.logger.Info(ctx, "Author created successfully",
 		logger.String("author_id", fmt.Sprintf("%d", author.ID)),
 		logger.String("slug", slug))
@@ -104,7 +162,11 @@ func (s *AuthorService) Update(ctx context.Context, id uint, firstName, lastName
 	if err != nil {
 		var baseErr *errors.BaseError
 		if stderrors.As(err, &baseErr) && baseErr.Kind == errors.ErrKindTransient {
-			return s.retryUpdate(ctx, id, firstName, lastName, profileImageURL)
+			if err := s.retryUpdate(ctx, id, firstName, lastName, profileImageURL); err != nil {
+				return err
+			}
+			s.publishUpdated(ctx, id)
+			return nil
 		}
 		s.logger.Error(ctx, "Failed to get author for update",
 			logger.String("error", err.Error()),
@@ -132,7 +194,11 @@ func (s *AuthorService) Update(ctx context.Context, id uint, firstName, lastName
 	if err := s.repo.Update(ctx, author); err != nil {
 		var baseErr *errors.BaseError
 		if stderrors.As(err, &baseErr) && baseErr.Kind == errors.ErrKindTransient {
-			return s.retryUpdate(ctx, id, firstName, lastName, profileImageURL)
+			if err := s.retryUpdate(ctx, id, firstName, lastName, profileImageURL); err != nil {
+				return err
+			}
+			s.publishUpdated(ctx, id)
+			return nil
 		}
 		s.logger.Error(ctx, "Failed to save author update",
 			logger.String("error", err.Error()),
@@ -145,6 +211,8 @@ func (s *AuthorService) Update(ctx context.Context, id uint, firstName, lastName
 		return err
 	}
 
+	s.publishUpdated(ctx, id)
+
 	s.logger.Info(ctx, "Author updated successfully",
 		logger.String("author_id", fmt.Sprintf("%d", id)))
 
@@ -172,10 +240,26 @@ func (s *AuthorService) Delete(ctx context.Context, id uint) error {
 		"author_id:" + fmt.Sprintf("%d", id),
 	})
 
-	if err := s.repo.Delete(ctx, id); err != nil {
+	if err := s.applyDeletePolicy(ctx, id); err != nil {
+		s.logger.Warn(ctx, "Failed to apply author delete policy",
+			logger.String("error", err.Error()),
+			logger.String("author_id", fmt.Sprintf("%d", id)),
+			logger.String("policy", string(s.deletePolicy.Mode)))
+		s.metrics.IncrementCounter("author.delete.error", []string{
+			"author_id:" + fmt.Sprintf("%d", id),
+			"error_type:stories",
+		})
+		return err
+	}
+
+	if err := s.repo.Delete(ctx, id, s.softDelete()); err != nil {
 		var baseErr *errors.BaseError
 		if stderrors.As(err, &baseErr) && baseErr.Kind == errors.ErrKindTransient {
-			return s.retryDelete(ctx, id)
+			if err := s.retryDelete(ctx, id); err != nil {
+				return err
+			}
+			s.publishDeleted(ctx, id)
+			return nil
 		}
 		s.logger.Error(ctx, "Failed to delete author",
 			logger.String("error", err.Error()),
@@ -188,6 +272,8 @@ func (s *AuthorService) Delete(ctx context.Context, id uint) error {
 		return err
 	}
 
+	s.publishDeleted(ctx, id)
+
 	s.logger.Info(ctx, "Author deleted successfully",
 		logger.String("author_id", fmt.Sprintf("%d", id)))
 
@@ -206,6 +292,58 @@ func (s *AuthorService) Delete(ctx context.Context, id uint) error {
 }
 
 // Read Operations (Queries)
+
+// applyDeletePolicy deals with the author's stories before the author is removed: block
+// refuses while stories remain, reassign moves them to the configured author and
+// cascade deletes them. The story module publishes an event for every story it
+// changes, so each module cleans up what refers to them.
+func (s *AuthorService) applyDeletePolicy(ctx context.Context, id uint) error {
+	switch s.deletePolicy.Mode {
+	case domain.DeleteReassign:
+		target := s.deletePolicy.ReassignTo
+		if target == id {
+			return domain.NewAuthorError("an author's stories cannot be reassigned to itself")
+		}
+		if _, err := s.repo.GetByID(ctx, target); err != nil {
+			return err
+		}
+		_, err := s.stories.ReassignAuthor(ctx, id, target)
+		return err
+	case domain.DeleteCascade:
+		_, err := s.stories.DeleteByAuthor(ctx, id)
+		return err
+	default:
+		count, err := s.stories.CountByAuthor(ctx, id)
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			return domain.NewAuthorHasStoriesError(id, count)
+		}
+		return nil
+	}
+}
+
+// softDelete reports whether deleted authors are kept as soft-deleted records, which
+// cascade does so that the stories deleted with them still resolve their author
+func (s *AuthorService) softDelete() bool {
+	return s.deletePolicy.Mode == domain.DeleteCascade
+}
+
+// MissingAuthors returns the IDs among ids that do not belong to an existing author.
+// It is the author module's side of the story module's AuthorDirectory port.
+func (s *AuthorService) MissingAuthors(ctx context.Context, ids []uint) ([]uint, error) {
+	missing, err := s.repo.MissingIDs(ctx, ids)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to check authors exist",
+			logger.String("error", err.Error()),
+			logger.Int("authors", len(ids)))
+		s.metrics.IncrementCounter("author.exists.error", nil)
+		return nil, err
+	}
+	return missing, nil
+}
+
 func (s *AuthorService) GetByID(ctx context.Context, id uint) (*domain.Author, error) {
 	start := time.Now()
 	s.logger.Debug(ctx, "Getting author by ID",
@@ -264,6 +402,30 @@ func (s *AuthorService) GetByID(ctx context.Context, id uint) (*domain.Author, e
 	return author, nil
 }
 
+// GetByIDs returns the existing authors among ids. Missing authors are left out.
+func (s *AuthorService) GetByIDs(ctx context.Context, ids []uint) ([]*domain.Author, error) {
+	start := time.Now()
+	authors, err := s.repo.GetByIDs(ctx, ids)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to get authors",
+			logger.String("error", err.Error()),
+			logger.Int("authors", len(ids)))
+		s.metrics.IncrementCounter("author.fetch.error", []string{
+			"error_type:repository",
+			"type:ids",
+		})
+		return nil, err
+	}
+
+	s.metrics.IncrementCounter("author.fetch.success", []string{
+		"type:ids",
+	})
+	s.metrics.RecordTiming("author.fetch.duration", time.Since(start), []string{
+		"type:ids",
+	})
+	return authors, nil
+}
+
 func (s *AuthorService) GetBySlug(ctx context.Context, slug string) (*domain.Author, error) {
 	start := time.Now()
 	s.logger.Debug(ctx, "Getting author by slug",
@@ -322,10 +484,280 @@ func (s *AuthorService) GetBySlug(ctx context.Context, slug string) (*domain.Aut
 	return author, nil
 }
 
+// ChangeSlug gives the author a new slug. The previous slug is kept in the
+// history so GetBySlug still resolves it.
+func (s *AuthorService) ChangeSlug(ctx context.Context, id uint, slug string) (*domain.Author, error) {
+	start := time.Now()
+	s.logger.Info(ctx, "Changing author slug",
+		logger.String("author_id", fmt.Sprintf("%d", id)),
+		logger.String("slug", slug))
+
+	author, err := s.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	previous, err := author.ChangeSlug(slug)
+	if err != nil {
+		s.metrics.IncrementCounter("author.slug.error", []string{
+			"author_id:" + fmt.Sprintf("%d", id),
+			"error_type:validation",
+		})
+		return nil, err
+	}
+
+	if err := s.repo.ChangeSlug(ctx, author, previous); err != nil {
+		s.logger.Error(ctx, "Failed to change author slug",
+			logger.String("error", err.Error()),
+			logger.String("author_id", fmt.Sprintf("%d", id)))
+		s.metrics.IncrementCounter("author.slug.error", []string{
+			"author_id:" + fmt.Sprintf("%d", id),
+			"error_type:repository",
+		})
+		return nil, err
+	}
+
+	s.publishUpdated(ctx, id)
+
+	s.metrics.IncrementCounter("author.slug.success", []string{
+		"author_id:" + fmt.Sprintf("%d", id),
+	})
+	s.metrics.RecordTiming("author.slug.duration", time.Since(start), nil)
+	return author, nil
+}
+
+// UpdateProfile replaces the author's bio, location and links
+func (s *AuthorService) UpdateProfile(ctx context.Context, id uint, bio, location string, links []domain.Link) (*domain.Author, error) {
+	return s.modify(ctx, id, "profile", func(author *domain.Author) error {
+		return author.UpdateProfile(bio, location, links)
+	})
+}
+
+// Verify gives the author a verification badge on behalf of the verifying user
+func (s *AuthorService) Verify(ctx context.Context, id uint, verifiedBy string) (*domain.Author, error) {
+	return s.modify(ctx, id, "verify", func(author *domain.Author) error {
+		return author.Verify(verifiedBy)
+	})
+}
+
+// Unverify removes the author's verification badge
+func (s *AuthorService) Unverify(ctx context.Context, id uint) (*domain.Author, error) {
+	return s.modify(ctx, id, "unverify", func(author *domain.Author) error {
+		author.Unverify()
+		return nil
+	})
+}
+
+// MergeAuthors merges a duplicate source author into the target: the source's stories
+// and followers move to the target, its slugs redirect to the target and it is soft-deleted.
+// With dryRun nothing changes and the returned plan previews what would move.
+func (s *AuthorService) MergeAuthors(ctx context.Context, sourceID, targetID uint, mergedBy string, dryRun bool) (*domain.MergePlan, error) {
+	start := time.Now()
+	tags := []string{
+		"source_id:" + fmt.Sprintf("%d", sourceID),
+		"target_id:" + fmt.Sprintf("%d", targetID),
+		"dry_run:" + fmt.Sprintf("%t", dryRun),
+	}
+
+	if err := domain.ValidateMerge(sourceID, targetID); err != nil {
+		s.metrics.IncrementCounter("author.merge.error", append(tags, "error_type:validation"))
+		return nil, err
+	}
+
+	if dryRun {
+		plan, err := s.repo.PlanMerge(ctx, sourceID, targetID)
+		if err != nil {
+			s.logger.Error(ctx, "Failed to plan author merge",
+				logger.String("error", err.Error()),
+				logger.String("source_id", fmt.Sprintf("%d", sourceID)),
+				logger.String("target_id", fmt.Sprintf("%d", targetID)))
+			s.metrics.IncrementCounter("author.merge.error", append(tags, "error_type:repository"))
+			return nil, err
+		}
+		s.metrics.IncrementCounter("author.merge.success", tags)
+		return plan, nil
+	}
+
+	s.logger.Info(ctx, "Merging authors",
+		logger.String("source_id", fmt.Sprintf("%d", sourceID)),
+		logger.String("target_id", fmt.Sprintf("%d", targetID)),
+		logger.String("merged_by", mergedBy))
+
+	plan, err := s.repo.Merge(ctx, sourceID, targetID, mergedBy)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to merge authors",
+			logger.String("error", err.Error()),
+			logger.String("source_id", fmt.Sprintf("%d", sourceID)),
+			logger.String("target_id", fmt.Sprintf("%d", targetID)))
+		s.metrics.IncrementCounter("author.merge.error", append(tags, "error_type:repository"))
+		return nil, err
+	}
+
+	s.events.Publish(ctx, domain.AuthorsMerged{
+		SourceID: sourceID,
+		TargetID: targetID,
+		Stories:  plan.Stories,
+		MergedAt: time.Now(),
+	})
+
+	s.logger.Info(ctx, "Authors merged successfully",
+		logger.String("source_id", fmt.Sprintf("%d", sourceID)),
+		logger.String("target_id", fmt.Sprintf("%d", targetID)),
+		logger.Int64("stories", plan.Stories),
+		logger.Int64("followers", plan.Followers))
+	s.metrics.IncrementCounter("author.merge.success", tags)
+	s.metrics.RecordTiming("author.merge.duration", time.Since(start), nil)
+	return plan, nil
+}
+
+// modify loads the author, applies a domain change and saves the result
+func (s *AuthorService) modify(ctx context.Context, id uint, operation string, change func(*domain.Author) error) (*domain.Author, error) {
+	start := time.Now()
+	s.logger.Info(ctx, "Modifying author",
+		logger.String("author_id", fmt.Sprintf("%d", id)),
+		logger.String("operation", operation))
+
+	author, err := s.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := change(author); err != nil {
+		s.metrics.IncrementCounter("author."+operation+".error", []string{
+			"author_id:" + fmt.Sprintf("%d", id),
+			"error_type:validation",
+		})
+		return nil, err
+	}
+
+	if err := s.repo.Update(ctx, author); err != nil {
+		s.logger.Error(ctx, "Failed to save author",
+			logger.String("error", err.Error()),
+			logger.String("author_id", fmt.Sprintf("%d", id)),
+			logger.String("operation", operation))
+		s.metrics.IncrementCounter("author."+operation+".error", []string{
+			"author_id:" + fmt.Sprintf("%d", id),
+			"error_type:repository",
+		})
+		return nil, err
+	}
+
+	s.publishUpdated(ctx, id)
+
+	s.metrics.IncrementCounter("author."+operation+".success", []string{
+		"author_id:" + fmt.Sprintf("%d", id),
+	})
+	s.metrics.RecordTiming("author."+operation+".duration", time.Since(start), nil)
+	return author, nil
+}
+
+// GenerateSlug returns an unused slug derived from the author's name, adding a
+// numeric suffix when the plain form is already taken
+func (s *AuthorService) GenerateSlug(ctx context.Context, firstName, lastName string) (string, error) {
+	base := domain.BaseSlug(firstName, lastName)
+	for n := 1; n <= maxSlugCandidates; n++ {
+		candidate := domain.SlugCandidate(base, n)
+		exists, err := s.repo.SlugExists(ctx, candidate)
+		if err != nil {
+			return "", err
+		}
+		if !exists {
+			return candidate, nil
+		}
+	}
+	return "", domain.NewAuthorAlreadyExistsError(base)
+}
+
+// checkSlugAvailable rejects a slug held by an author or kept in an author's slug
+// history, as GetBySlug still resolves previous slugs
+func (s *AuthorService) checkSlugAvailable(ctx context.Context, slug string) error {
+	exists, err := s.repo.SlugExists(ctx, slug)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return domain.NewAuthorAlreadyExistsError(slug)
+	}
+	return nil
+}
+
+// List returns a page of authors matching the raw listing parameters
+func (s *AuthorService) List(ctx context.Context, prefix, sort, order, cursor string, limit int) (*domain.AuthorPage, error) {
+	start := time.Now()
+	s.logger.Debug(ctx, "Listing authors",
+		logger.String("prefix", prefix),
+		logger.String("sort", sort),
+		logger.Int("limit", limit))
+
+	query, err := domain.NewListQuery(prefix, sort, order, cursor, limit)
+	if err != nil {
+		// Record validation error
+		s.metrics.IncrementCounter("author.list.error", []string{
+			"error_type:validation",
+		})
+		return nil, err
+	}
+
+	page, err := s.repo.List(ctx, query)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to list authors",
+			logger.String("error", err.Error()),
+			logger.String("sort", string(query.Sort)))
+		// Record list error
+		s.metrics.IncrementCounter("author.list.error", []string{
+			"sort:" + string(query.Sort),
+			"error_type:repository",
+		})
+		return nil, err
+	}
+
+	// Record successful author list
+	s.metrics.IncrementCounter("author.list.success", []string{
+		"sort:" + string(query.Sort),
+		"count:" + fmt.Sprintf("%d", len(page.Authors)),
+	})
+
+	// Record operation duration
+	duration := time.Since(start)
+	s.metrics.RecordTiming("author.list.duration", duration, []string{
+		"sort:" + string(query.Sort),
+	})
+
+	return page, nil
+}
+
+// Event Publishing
+
+func (s *AuthorService) publishCreated(ctx context.Context, author *domain.Author) {
+	s.events.Publish(ctx, domain.AuthorCreated{
+		AuthorID:  author.ID,
+		Slug:      author.Slug,
+		CreatedAt: author.CreatedAt,
+	})
+}
+
+func (s *AuthorService) publishUpdated(ctx context.Context, id uint) {
+	s.events.Publish(ctx, domain.AuthorUpdated{
+		AuthorID:  id,
+		UpdatedAt: time.Now(),
+	})
+}
+
+func (s *AuthorService) publishDeleted(ctx context.Context, id uint) {
+	event := domain.AuthorDeleted{
+		AuthorID:  id,
+		DeletedAt: time.Now(),
+	}
+	if s.deletePolicy.Mode == domain.DeleteReassign {
+		event.ReassignedTo = s.deletePolicy.ReassignTo
+	}
+	s.events.Publish(ctx, event)
+}
+
 // Retry Operations
-func (s *AuthorService) retryCreate(ctx context.Context, author *domain.Author) (*domain.Author, error) {
+func (s *AuthorService) retryCreate(ctx context.Context, author *domain.Author, ownerID string) (*domain.Author, error) {
 	for i := 0; i < 3; i++ {
-		createErr := s.repo.Create(ctx, author)
+		createErr := s.repo.Create(ctx, author, ownerID)
 		if createErr == nil {
 			return author, nil
 		}
@@ -366,7 +798,7 @@ func (s *AuthorService) retryUpdate(ctx context.Context, id uint, firstName, las
 
 func (s *AuthorService) retryDelete(ctx context.Context, id uint) error {
 	for i := 0; i < 3; i++ {
-		err := s.repo.Delete(ctx, id)
+		err := s.repo.Delete(ctx, id, s.softDelete())
 		if err == nil {
 			return nil
 		}
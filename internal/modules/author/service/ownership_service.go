@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"go-monolith/internal/modules/author/domain"
+	"go-monolith/internal/modules/author/repository"
+	"go-monolith/pkg/logger"
+	"go-monolith/pkg/metrics"
+)
+
+// OwnershipService manages which user accounts may act on behalf of an author
+type OwnershipService struct {
+	repo    repository.OwnershipRepository
+	authors repository.AuthorRepository
+	logger  logger.Logger
+	metrics *metrics.Client
+}
+
+func NewOwnershipService(repo repository.OwnershipRepository, authors repository.AuthorRepository, logger logger.Logger, metrics *metrics.Client) *OwnershipService {
+	return &OwnershipService{
+		repo:    repo,
+		authors: authors,
+		logger:  logger,
+		metrics: metrics,
+	}
+}
+
+// Write Operations (Commands)
+
+// AddOwner lets the user manage the author
+func (s *OwnershipService) AddOwner(ctx context.Context, authorID uint, userID string) error {
+	ownership, err := domain.NewOwnership(authorID, userID)
+	if err != nil {
+		s.metrics.IncrementCounter("author.owner.add.error", []string{"error_type:validation"})
+		return err
+	}
+	if _, err := s.authors.GetByID(ctx, authorID); err != nil {
+		s.metrics.IncrementCounter("author.owner.add.error", []string{"error_type:author"})
+		return err
+	}
+
+	if err := s.repo.Add(ctx, ownership); err != nil {
+		s.logger.Error(ctx, "Failed to add author owner",
+			logger.String("error", err.Error()),
+			logger.String("author_id", fmt.Sprintf("%d", authorID)),
+			logger.String("user_id", userID))
+		s.metrics.IncrementCounter("author.owner.add.error", []string{"error_type:repository"})
+		return err
+	}
+
+	s.logger.Info(ctx, "Author owner added",
+		logger.String("author_id", fmt.Sprintf("%d", authorID)),
+		logger.String("user_id", userID))
+	s.metrics.IncrementCounter("author.owner.add.success", nil)
+	return nil
+}
+
+// RemoveOwner stops the user from managing the author. The last owner cannot be removed.
+func (s *OwnershipService) RemoveOwner(ctx context.Context, authorID uint, userID string) error {
+	if err := s.repo.Remove(ctx, authorID, userID); err != nil {
+		s.logger.Error(ctx, "Failed to remove author owner",
+			logger.String("error", err.Error()),
+			logger.String("author_id", fmt.Sprintf("%d", authorID)),
+			logger.String("user_id", userID))
+		s.metrics.IncrementCounter("author.owner.remove.error", nil)
+		return err
+	}
+
+	s.metrics.IncrementCounter("author.owner.remove.success", nil)
+	return nil
+}
+
+// BackfillOwners makes the user an owner of every author that has none and returns
+// their IDs. With dryRun nothing changes and the IDs of the unowned authors are returned.
+func (s *OwnershipService) BackfillOwners(ctx context.Context, userID string, dryRun bool) ([]uint, error) {
+	if _, err := domain.NewOwnership(0, userID); err != nil {
+		return nil, err
+	}
+
+	authorIDs, err := s.repo.ListUnownedAuthorIDs(ctx)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to list unowned authors", logger.String("error", err.Error()))
+		s.metrics.IncrementCounter("author.owner.backfill.error", nil)
+		return nil, err
+	}
+	if dryRun {
+		return authorIDs, nil
+	}
+
+	for i, authorID := range authorIDs {
+		ownership, _ := domain.NewOwnership(authorID, userID)
+		if err := s.repo.Add(ctx, ownership); err != nil {
+			s.logger.Error(ctx, "Failed to backfill author owner",
+				logger.String("error", err.Error()),
+				logger.String("author_id", fmt.Sprintf("%d", authorID)))
+			s.metrics.IncrementCounter("author.owner.backfill.error", nil)
+			return authorIDs[:i], err
+		}
+	}
+
+	s.logger.Info(ctx, "Author owners backfilled",
+		logger.String("user_id", userID),
+		logger.Int("authors", len(authorIDs)))
+	s.metrics.IncrementCounter("author.owner.backfill.success", []string{
+		"count:" + fmt.Sprintf("%d", len(authorIDs)),
+	})
+	return authorIDs, nil
+}
+
+// Read Operations (Queries)
+
+// IsOwner reports whether the user manages the author
+func (s *OwnershipService) IsOwner(ctx context.Context, authorID uint, userID string) (bool, error) {
+	if userID == "" {
+		return false, nil
+	}
+	owner, err := s.repo.IsOwner(ctx, authorID, userID)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to check author owner",
+			logger.String("error", err.Error()),
+			logger.String("author_id", fmt.Sprintf("%d", authorID)))
+		s.metrics.IncrementCounter("author.owner.check.error", nil)
+		return false, err
+	}
+	return owner, nil
+}
+
+// ListOwnerIDs returns the users managing the author
+func (s *OwnershipService) ListOwnerIDs(ctx context.Context, authorID uint) ([]string, error) {
+	return s.repo.ListOwnerIDs(ctx, authorID)
+}
+
+// ListOwnedAuthors returns the author personas the user manages
+func (s *OwnershipService) ListOwnedAuthors(ctx context.Context, userID string) ([]*domain.Author, error) {
+	authors, err := s.repo.ListAuthorsByOwner(ctx, userID)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to list owned authors",
+			logger.String("error", err.Error()),
+			logger.String("user_id", userID))
+		s.metrics.IncrementCounter("author.owner.list.error", nil)
+		return nil, err
+	}
+	return authors, nil
+}
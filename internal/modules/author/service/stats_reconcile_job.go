@@ -0,0 +1,22 @@
+package service
+
+import "context"
+
+// StatsReconcileJob periodically rebuilds the author aggregates from the stories.
+// It implements scheduler.Job.
+type StatsReconcileJob struct {
+	service *StatsService
+}
+
+func NewStatsReconcileJob(service *StatsService) *StatsReconcileJob {
+	return &StatsReconcileJob{service: service}
+}
+
+func (j *StatsReconcileJob) Name() string {
+	return "author.stats_reconcile"
+}
+
+func (j *StatsReconcileJob) Run(ctx context.Context) error {
+	_, err := j.service.Rebuild(ctx)
+	return err
+}
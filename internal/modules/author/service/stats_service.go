@@ -0,0 +1,163 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go-monolith/internal/modules/author/domain"
+	"go-monolith/internal/modules/author/repository"
+	storydomain "go-monolith/internal/modules/story/domain"
+	"go-monolith/pkg/events"
+	"go-monolith/pkg/logger"
+	"go-monolith/pkg/metrics"
+)
+
+// StatsService maintains the per-author story aggregates
+type StatsService struct {
+	repo    repository.StatsRepository
+	logger  logger.Logger
+	metrics *metrics.Client
+}
+
+func NewStatsService(repo repository.StatsRepository, logger logger.Logger, metrics *metrics.Client) *StatsService {
+	return &StatsService{
+		repo:    repo,
+		logger:  logger,
+		metrics: metrics,
+	}
+}
+
+// Event Handlers
+
+// HandleStoryPublished counts newly published stories and tracks publish dates
+func (s *StatsService) HandleStoryPublished(ctx context.Context, event events.Event) {
+	published, ok := event.(storydomain.StoryPublished)
+	if !ok {
+		return
+	}
+	err := s.repo.AddPublished(ctx, published.AuthorID, published.PublishedAt)
+	s.recordUpdate(ctx, "published", published.AuthorID, err)
+}
+
+// HandleStoryEngaged adds likes to the author's totals. Views are added in bulk once
+// they are compacted, so reading a story does not write the author's row.
+func (s *StatsService) HandleStoryEngaged(ctx context.Context, event events.Event) {
+	engaged, ok := event.(storydomain.StoryEngaged)
+	if !ok || engaged.Kind != storydomain.EngagementLike {
+		return
+	}
+	err := s.repo.AddLike(ctx, engaged.AuthorID)
+	s.recordUpdate(ctx, "engaged", engaged.AuthorID, err)
+}
+
+// HandleViewsAdded adds the compacted views of the authors' stories to their totals
+func (s *StatsService) HandleViewsAdded(ctx context.Context, event events.Event) {
+	added, ok := event.(storydomain.ViewsAdded)
+	if !ok {
+		return
+	}
+	for _, views := range added.Views {
+		err := s.repo.AddViews(ctx, views.AuthorID, views.Views)
+		s.recordUpdate(ctx, "viewed", views.AuthorID, err)
+	}
+}
+
+// HandleStoryDeleted removes a deleted story from the author's aggregates
+func (s *StatsService) HandleStoryDeleted(ctx context.Context, event events.Event) {
+	deleted, ok := event.(storydomain.StoryDeleted)
+	if !ok {
+		return
+	}
+	err := s.repo.RemoveStory(ctx, deleted.AuthorID, deleted.PublishedAt, deleted.Views, deleted.Likes)
+	s.recordUpdate(ctx, "deleted", deleted.AuthorID, err)
+}
+
+// HandleAuthorDeleted drops the aggregates of a deleted author, recomputing those
+// of the author that received its stories
+func (s *StatsService) HandleAuthorDeleted(ctx context.Context, event events.Event) {
+	deleted, ok := event.(domain.AuthorDeleted)
+	if !ok {
+		return
+	}
+	err := s.repo.Delete(ctx, deleted.AuthorID)
+	if err == nil && deleted.ReassignedTo != 0 {
+		err = s.repo.RebuildAuthor(ctx, deleted.ReassignedTo)
+	}
+	s.recordUpdate(ctx, "author_deleted", deleted.AuthorID, err)
+}
+
+// HandleAuthorsMerged recomputes the target's aggregates from the stories it received
+func (s *StatsService) HandleAuthorsMerged(ctx context.Context, event events.Event) {
+	merged, ok := event.(domain.AuthorsMerged)
+	if !ok {
+		return
+	}
+	err := s.repo.Delete(ctx, merged.SourceID)
+	if err == nil {
+		err = s.repo.RebuildAuthor(ctx, merged.TargetID)
+	}
+	s.recordUpdate(ctx, "merged", merged.TargetID, err)
+}
+
+func (s *StatsService) recordUpdate(ctx context.Context, cause string, authorID uint, err error) {
+	if err != nil {
+		s.logger.Error(ctx, "Failed to update author stats",
+			logger.String("error", err.Error()),
+			logger.String("author_id", fmt.Sprintf("%d", authorID)),
+			logger.String("cause", cause))
+		s.metrics.IncrementCounter("author.stats.update.error", []string{
+			"cause:" + cause,
+		})
+		return
+	}
+	s.metrics.IncrementCounter("author.stats.update.success", []string{
+		"cause:" + cause,
+	})
+}
+
+// Write Operations (Commands)
+
+// Rebuild recomputes all aggregates from the stories, correcting drift from missed events
+func (s *StatsService) Rebuild(ctx context.Context) (int64, error) {
+	start := time.Now()
+	rows, err := s.repo.Rebuild(ctx)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to rebuild author stats",
+			logger.String("error", err.Error()))
+		s.metrics.IncrementCounter("author.stats.rebuild.error", nil)
+		return 0, err
+	}
+
+	s.logger.Info(ctx, "Rebuilt author stats", logger.Int64("rows", rows))
+	s.metrics.RecordTiming("author.stats.rebuild.duration", time.Since(start), nil)
+	return rows, nil
+}
+
+// Read Operations (Queries)
+
+// GetStats returns the aggregates of an author
+func (s *StatsService) GetStats(ctx context.Context, authorID uint) (*domain.Stats, error) {
+	stats, err := s.repo.Get(ctx, authorID)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to get author stats",
+			logger.String("error", err.Error()),
+			logger.String("author_id", fmt.Sprintf("%d", authorID)))
+		s.metrics.IncrementCounter("author.stats.fetch.error", nil)
+		return nil, err
+	}
+	return stats, nil
+}
+
+// GetManyStats returns the aggregates of several authors keyed by author ID
+func (s *StatsService) GetManyStats(ctx context.Context, authorIDs []uint) (map[uint]*domain.Stats, error) {
+	stats, err := s.repo.GetMany(ctx, authorIDs)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to get author stats",
+			logger.String("error", err.Error()),
+			logger.Int("authors", len(authorIDs)))
+		s.metrics.IncrementCounter("author.stats.fetch.error", nil)
+		return nil, err
+	}
+	return stats, nil
+}
@@ -0,0 +1,24 @@
+package domain
+
+import (
+	"go-monolith/pkg/errors"
+)
+
+// FollowError represents follow-specific domain errors
+type FollowError struct {
+	errors.BaseError
+}
+
+func NewFollowError(message string) error {
+	return &FollowError{
+		BaseError: errors.BaseError{
+			Kind:    errors.ErrKindValidation,
+			Message: message,
+		},
+	}
+}
+
+// Domain-specific error constructors
+func NewFollowNotFoundError(authorID string) error {
+	return errors.NewNotFoundError("follow of author", authorID)
+}
@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"strconv"
+	"time"
+)
+
+// Follow records that a user follows an author
+type Follow struct {
+	UserID    string
+	AuthorID  uint
+	CreatedAt time.Time
+}
+
+// NewFollow validates and creates a follow of the author by the user
+func NewFollow(userID, authorID string) (*Follow, error) {
+	if userID == "" {
+		return nil, NewFollowError("user ID cannot be empty")
+	}
+	id, err := ParseAuthorID(authorID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Follow{
+		UserID:    userID,
+		AuthorID:  id,
+		CreatedAt: time.Now(),
+	}, nil
+}
+
+// ParseAuthorID parses a raw author ID
+func ParseAuthorID(authorID string) (uint, error) {
+	if authorID == "" {
+		return 0, NewFollowError("author ID cannot be empty")
+	}
+	id, err := strconv.ParseUint(authorID, 10, 64)
+	if err != nil || id == 0 {
+		return 0, NewFollowError("invalid author ID format")
+	}
+	return uint(id), nil
+}
@@ -0,0 +1,28 @@
+package follow
+
+import (
+	"gorm.io/gorm"
+
+	authordomain "go-monolith/internal/modules/author/domain"
+	"go-monolith/internal/modules/follow/repository"
+	"go-monolith/internal/modules/follow/service"
+	"go-monolith/pkg/events"
+	"go-monolith/pkg/logger"
+	"go-monolith/pkg/metrics"
+)
+
+type Module struct {
+	FollowService *service.FollowService
+}
+
+func NewModule(db *gorm.DB, bus *events.Bus, logger logger.Logger, metrics *metrics.Client) *Module {
+	repo := repository.NewFollowRepository(db)
+	followService := service.NewFollowService(repo, logger, metrics)
+
+	// Drop follows of deleted authors
+	bus.Subscribe(authordomain.EventAuthorDeleted, followService.HandleAuthorDeleted)
+
+	return &Module{
+		FollowService: followService,
+	}
+}
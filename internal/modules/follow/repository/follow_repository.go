@@ -0,0 +1,166 @@
+package repository
+
+import (
+	"context"
+	stderrors "errors"
+	"strconv"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"go-monolith/internal/modules/follow/domain"
+	"go-monolith/pkg/errors"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+// followModel represents the database model
+type followModel struct {
+	UserID    string    `gorm:"primaryKey;type:varchar(64)"`
+	AuthorID  uint      `gorm:"primaryKey;index"`
+	CreatedAt time.Time `gorm:"not null;default:CURRENT_TIMESTAMP"`
+}
+
+// TableName sets the insert table name for this struct type
+func (followModel) TableName() string {
+	return "author_follows"
+}
+
+// In this context, Only benefit of using interface is to allow for mocking in tests, otherwise not needed
+type FollowRepository interface {
+	Create(ctx context.Context, follow *domain.Follow) error
+	Delete(ctx context.Context, userID string, authorID uint) error
+	Exists(ctx context.Context, userID string, authorID uint) (bool, error)
+	ListAuthorIDs(ctx context.Context, userID string) ([]uint, error)
+	ListFollowerIDs(ctx context.Context, authorID uint) ([]string, error)
+	CountFollowers(ctx context.Context, authorID uint) (int64, error)
+	DeleteByAuthor(ctx context.Context, authorID uint) error
+}
+
+type followRepository struct {
+	db *gorm.DB
+}
+
+func NewFollowRepository(db *gorm.DB) FollowRepository {
+	return &followRepository{db: db}
+}
+
+// Create stores the follow. Following an author twice is a no-op.
+func (r *followRepository) Create(ctx context.Context, follow *domain.Follow) error {
+	model := toModel(follow)
+	err := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(model).Error
+	if err != nil {
+		return wrapError(err)
+	}
+	return nil
+}
+
+func (r *followRepository) Delete(ctx context.Context, userID string, authorID uint) error {
+	result := r.db.WithContext(ctx).
+		Where("user_id = ? AND author_id = ?", userID, authorID).
+		Delete(&followModel{})
+	if result.Error != nil {
+		return wrapError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return domain.NewFollowNotFoundError(strconv.FormatUint(uint64(authorID), 10))
+	}
+	return nil
+}
+
+func (r *followRepository) Exists(ctx context.Context, userID string, authorID uint) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&followModel{}).
+		Where("user_id = ? AND author_id = ?", userID, authorID).
+		Count(&count).Error
+	if err != nil {
+		return false, wrapError(err)
+	}
+	return count > 0, nil
+}
+
+// ListAuthorIDs returns the IDs of all authors the user follows
+func (r *followRepository) ListAuthorIDs(ctx context.Context, userID string) ([]uint, error) {
+	var ids []uint
+	err := r.db.WithContext(ctx).
+		Model(&followModel{}).
+		Where("user_id = ?", userID).
+		Order("created_at ASC").
+		Pluck("author_id", &ids).Error
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	return ids, nil
+}
+
+// ListFollowerIDs returns the IDs of all users following the author
+func (r *followRepository) ListFollowerIDs(ctx context.Context, authorID uint) ([]string, error) {
+	var ids []string
+	err := r.db.WithContext(ctx).
+		Model(&followModel{}).
+		Where("author_id = ?", authorID).
+		Pluck("user_id", &ids).Error
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	return ids, nil
+}
+
+func (r *followRepository) CountFollowers(ctx context.Context, authorID uint) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&followModel{}).
+		Where("author_id = ?", authorID).
+		Count(&count).Error
+	if err != nil {
+		return 0, wrapError(err)
+	}
+	return count, nil
+}
+
+// DeleteByAuthor removes every follow of the author
+func (r *followRepository) DeleteByAuthor(ctx context.Context, authorID uint) error {
+	err := r.db.WithContext(ctx).
+		Where("author_id = ?", authorID).
+		Delete(&followModel{}).Error
+	if err != nil {
+		return wrapError(err)
+	}
+	return nil
+}
+
+// toModel converts domain follow to database model
+func toModel(follow *domain.Follow) *followModel {
+	return &followModel{
+		UserID:    follow.UserID,
+		AuthorID:  follow.AuthorID,
+		CreatedAt: follow.CreatedAt,
+	}
+}
+
+func wrapError(err error) error {
+	if isTransientError(err) {
+		return errors.NewTransientError(err)
+	}
+	return errors.NewUnexpectedError(err)
+}
+
+func isTransientError(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	if !stderrors.As(err, &mysqlErr) {
+		return false
+	}
+	// Common MySQL transient error codes
+	switch mysqlErr.Number {
+	case 1213, // Deadlock
+		1205, // Lock wait timeout
+		2006, // MySQL server has gone away
+		2013: // Lost connection to MySQL server
+		return true
+	}
+	return false
+}
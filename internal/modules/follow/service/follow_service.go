@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	authordomain "go-monolith/internal/modules/author/domain"
+	"go-monolith/internal/modules/follow/domain"
+	"go-monolith/internal/modules/follow/repository"
+	"go-monolith/pkg/events"
+	"go-monolith/pkg/logger"
+	"go-monolith/pkg/metrics"
+)
+
+type FollowService struct {
+	repo    repository.FollowRepository
+	logger  logger.Logger
+	metrics *metrics.Client
+}
+
+func NewFollowService(repo repository.FollowRepository, logger logger.Logger, metrics *metrics.Client) *FollowService {
+	return &FollowService{
+		repo:    repo,
+		logger:  logger,
+		metrics: metrics,
+	}
+}
+
+// Event Handlers
+
+// HandleAuthorDeleted removes the follows of a deleted author
+func (s *FollowService) HandleAuthorDeleted(ctx context.Context, event events.Event) {
+	deleted, ok := event.(authordomain.AuthorDeleted)
+	if !ok {
+		return
+	}
+
+	if err := s.repo.DeleteByAuthor(ctx, deleted.AuthorID); err != nil {
+		s.logger.Error(ctx, "Failed to delete follows of author",
+			logger.String("error", err.Error()),
+			logger.String("author_id", fmt.Sprintf("%d", deleted.AuthorID)))
+		s.metrics.IncrementCounter("follow.delete.error", []string{
+			"error_type:repository",
+			"type:author",
+		})
+	}
+}
+
+// Write Operations (Commands)
+
+// Follow makes the user follow the author
+func (s *FollowService) Follow(ctx context.Context, userID, authorID string) (*domain.Follow, error) {
+	start := time.Now()
+	s.logger.Info(ctx, "Following author", logger.String("author_id", authorID))
+
+	follow, err := domain.NewFollow(userID, authorID)
+	if err != nil {
+		s.metrics.IncrementCounter("follow.create.error", []string{
+			"error_type:validation",
+		})
+		return nil, err
+	}
+
+	if err := s.repo.Create(ctx, follow); err != nil {
+		s.logger.Error(ctx, "Failed to save follow",
+			logger.String("error", err.Error()),
+			logger.String("author_id", authorID))
+		s.metrics.IncrementCounter("follow.create.error", []string{
+			"error_type:repository",
+		})
+		return nil, err
+	}
+
+	s.metrics.IncrementCounter("follow.create.success", nil)
+	s.metrics.RecordTiming("follow.create.duration", time.Since(start), nil)
+	return follow, nil
+}
+
+// Unfollow removes the user's follow of the author
+func (s *FollowService) Unfollow(ctx context.Context, userID, authorID string) error {
+	s.logger.Info(ctx, "Unfollowing author", logger.String("author_id", authorID))
+
+	id, err := domain.ParseAuthorID(authorID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.repo.Delete(ctx, userID, id); err != nil {
+		s.metrics.IncrementCounter("follow.delete.error", nil)
+		return err
+	}
+
+	s.metrics.IncrementCounter("follow.delete.success", nil)
+	return nil
+}
+
+// Read Operations (Queries)
+
+// IsFollowing reports whether the user follows the author
+func (s *FollowService) IsFollowing(ctx context.Context, userID, authorID string) (bool, error) {
+	id, err := domain.ParseAuthorID(authorID)
+	if err != nil {
+		return false, err
+	}
+	return s.repo.Exists(ctx, userID, id)
+}
+
+// ListFollowedAuthorIDs returns the IDs of the authors the user follows
+func (s *FollowService) ListFollowedAuthorIDs(ctx context.Context, userID string) ([]uint, error) {
+	ids, err := s.repo.ListAuthorIDs(ctx, userID)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to list followed authors",
+			logger.String("error", err.Error()))
+		s.metrics.IncrementCounter("follow.list.error", []string{
+			"error_type:repository",
+		})
+		return nil, err
+	}
+	return ids, nil
+}
+
+// ListFollowerIDs returns the IDs of the users following the author
+func (s *FollowService) ListFollowerIDs(ctx context.Context, authorID uint) ([]string, error) {
+	ids, err := s.repo.ListFollowerIDs(ctx, authorID)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to list followers",
+			logger.String("error", err.Error()))
+		s.metrics.IncrementCounter("follow.list.error", []string{
+			"error_type:repository",
+		})
+		return nil, err
+	}
+	return ids, nil
+}
+
+// CountFollowers returns the number of users following the author
+func (s *FollowService) CountFollowers(ctx context.Context, authorID string) (int64, error) {
+	id, err := domain.ParseAuthorID(authorID)
+	if err != nil {
+		return 0, err
+	}
+	return s.repo.CountFollowers(ctx, id)
+}
@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"fmt"
+
+	"go-monolith/pkg/errors"
+)
+
+// MediaError represents media-specific domain errors
+type MediaError struct {
+	errors.BaseError
+}
+
+func NewMediaError(message string) error {
+	return &MediaError{
+		BaseError: errors.BaseError{
+			Kind:    errors.ErrKindValidation,
+			Message: message,
+		},
+	}
+}
+
+// Domain-specific error constructors
+func NewMediaNotFoundError(id string) error {
+	return errors.NewNotFoundError("media", id)
+}
+
+func NewInvalidKindError(kind string) error {
+	return NewMediaError(fmt.Sprintf("invalid media kind '%s', expected cover or inline", kind))
+}
+
+func NewUnsupportedContentTypeError(contentType string) error {
+	return NewMediaError(fmt.Sprintf("unsupported content type '%s', expected image/jpeg, image/png or image/gif", contentType))
+}
+
+func NewEmptyFileError() error {
+	return NewMediaError("uploaded file is empty")
+}
+
+func NewFileTooLargeError(maxSize int64) error {
+	return NewMediaError(fmt.Sprintf("uploaded file exceeds the maximum size of %d bytes", maxSize))
+}
+
+func NewImageTooLargeError(maxDimension int) error {
+	return NewMediaError(fmt.Sprintf("image dimensions exceed the maximum of %d pixels per side", maxDimension))
+}
+
+func NewInvalidImageError() error {
+	return NewMediaError("uploaded file is not a valid image")
+}
@@ -0,0 +1,105 @@
+package domain
+
+import (
+	"strconv"
+	"time"
+)
+
+// Kind describes how a media item is used by a story
+type Kind string
+
+const (
+	KindCover  Kind = "cover"
+	KindInline Kind = "inline"
+)
+
+// allowedContentTypes lists the image formats accepted for upload and their file extensions
+var allowedContentTypes = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/gif":  ".gif",
+}
+
+// ThumbnailSizes maps thumbnail names to their maximum width in pixels
+var ThumbnailSizes = map[string]int{
+	"small":  160,
+	"medium": 480,
+	"large":  1024,
+}
+
+// Media represents an image attached to a story
+type Media struct {
+	ID          uint
+	StoryID     uint
+	Kind        Kind
+	ContentType string
+	Size        int64
+	Width       int
+	Height      int
+	StorageKey  string
+	// Thumbnails maps thumbnail names to their storage keys
+	Thumbnails map[string]string
+	CreatedAt  time.Time
+
+	// URL and ThumbnailURLs are resolved from the blob store when the media is read
+	URL           string
+	ThumbnailURLs map[string]string
+}
+
+func NewMedia(storyID, kind, contentType string, size, maxSize int64, width, height int) (*Media, error) {
+	if err := validateInputs(storyID, kind, contentType, size, maxSize); err != nil {
+		return nil, err
+	}
+	if width <= 0 || height <= 0 {
+		return nil, NewInvalidImageError()
+	}
+
+	storyIDUint, _ := strconv.ParseUint(storyID, 10, 64)
+	return &Media{
+		StoryID:     uint(storyIDUint),
+		Kind:        Kind(kind),
+		ContentType: contentType,
+		Size:        size,
+		Width:       width,
+		Height:      height,
+		Thumbnails:  make(map[string]string),
+		CreatedAt:   time.Now(),
+	}, nil
+}
+
+// Extension returns the file extension for the media content type
+func (m *Media) Extension() string {
+	return allowedContentTypes[m.ContentType]
+}
+
+// IsAllowedContentType reports whether images of the given type can be uploaded
+func IsAllowedContentType(contentType string) bool {
+	_, ok := allowedContentTypes[contentType]
+	return ok
+}
+
+// validateInputs performs validation on raw upload attributes
+func validateInputs(storyID, kind, contentType string, size, maxSize int64) error {
+	if _, err := strconv.ParseUint(storyID, 10, 64); err != nil {
+		return NewMediaError("invalid story ID format")
+	}
+
+	switch Kind(kind) {
+	case KindCover, KindInline:
+	default:
+		return NewInvalidKindError(kind)
+	}
+
+	if !IsAllowedContentType(contentType) {
+		return NewUnsupportedContentTypeError(contentType)
+	}
+
+	if size <= 0 {
+		return NewEmptyFileError()
+	}
+	if size > maxSize {
+		return NewFileTooLargeError(maxSize)
+	}
+
+	return nil
+}
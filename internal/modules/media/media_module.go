@@ -0,0 +1,30 @@
+package media
+
+import (
+	"gorm.io/gorm"
+
+	"go-monolith/internal/modules/media/repository"
+	"go-monolith/internal/modules/media/service"
+	storydomain "go-monolith/internal/modules/story/domain"
+	"go-monolith/pkg/events"
+	"go-monolith/pkg/logger"
+	"go-monolith/pkg/metrics"
+	"go-monolith/pkg/storage"
+)
+
+type Module struct {
+	MediaService *service.MediaService
+}
+
+func NewModule(db *gorm.DB, bus *events.Bus, store storage.BlobStore, maxUploadBytes int64, maxDimension int, logger logger.Logger, metrics *metrics.Client) *Module {
+	repo := repository.NewMediaRepository(db)
+
+	mediaService := service.NewMediaService(repo, store, maxUploadBytes, maxDimension, logger, metrics)
+
+	// Drop media and blobs of deleted stories
+	bus.Subscribe(storydomain.EventStoryDeleted, mediaService.HandleStoryDeleted)
+
+	return &Module{
+		MediaService: mediaService,
+	}
+}
@@ -0,0 +1,207 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"strconv"
+	"time"
+
+	"gorm.io/gorm"
+
+	"go-monolith/internal/modules/media/domain"
+	"go-monolith/pkg/errors"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+// mediaModel represents the database model
+type mediaModel struct {
+	ID          uint      `gorm:"primaryKey;autoIncrement"`
+	StoryID     uint      `gorm:"not null;index:idx_story_media_story_kind"`
+	Kind        string    `gorm:"type:varchar(16);not null;index:idx_story_media_story_kind"`
+	ContentType string    `gorm:"type:varchar(64);not null"`
+	Size        int64     `gorm:"not null"`
+	Width       int       `gorm:"not null"`
+	Height      int       `gorm:"not null"`
+	StorageKey  string    `gorm:"type:varchar(255);not null"`
+	Thumbnails  string    `gorm:"type:text;not null"`
+	CreatedAt   time.Time `gorm:"not null;default:CURRENT_TIMESTAMP"`
+}
+
+// TableName sets the insert table name for this struct type
+func (mediaModel) TableName() string {
+	return "story_media"
+}
+
+// In this context, Only benefit of using interface is to allow for mocking in tests, otherwise not needed
+type MediaRepository interface {
+	Create(ctx context.Context, media *domain.Media) error
+	GetByID(ctx context.Context, id string) (*domain.Media, error)
+	GetLatestByStory(ctx context.Context, storyID string, kind domain.Kind) (*domain.Media, error)
+	ListByStory(ctx context.Context, storyID string) ([]*domain.Media, error)
+	Delete(ctx context.Context, id string) error
+	DeleteByStory(ctx context.Context, storyID uint) error
+}
+
+type mediaRepository struct {
+	db *gorm.DB
+}
+
+func NewMediaRepository(db *gorm.DB) MediaRepository {
+	return &mediaRepository{db: db}
+}
+
+func (r *mediaRepository) Create(ctx context.Context, media *domain.Media) error {
+	model, err := toModel(media)
+	if err != nil {
+		return errors.NewUnexpectedError(err)
+	}
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		if isTransientError(err) {
+			return errors.NewTransientError(err)
+		}
+		return errors.NewUnexpectedError(err)
+	}
+	media.ID = model.ID
+	return nil
+}
+
+func (r *mediaRepository) GetByID(ctx context.Context, id string) (*domain.Media, error) {
+	var model mediaModel
+	idUint, _ := strconv.ParseUint(id, 10, 64)
+	if err := r.db.WithContext(ctx).First(&model, uint(idUint)).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, domain.NewMediaNotFoundError(id)
+		}
+		if isTransientError(err) {
+			return nil, errors.NewTransientError(err)
+		}
+		return nil, errors.NewUnexpectedError(err)
+	}
+	return toDomain(&model)
+}
+
+func (r *mediaRepository) GetLatestByStory(ctx context.Context, storyID string, kind domain.Kind) (*domain.Media, error) {
+	var model mediaModel
+	storyIDUint, _ := strconv.ParseUint(storyID, 10, 64)
+	err := r.db.WithContext(ctx).
+		Where("story_id = ? AND kind = ?", uint(storyIDUint), string(kind)).
+		Order("id DESC").
+		First(&model).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.NewNotFoundError(string(kind)+" media for story", storyID)
+		}
+		if isTransientError(err) {
+			return nil, errors.NewTransientError(err)
+		}
+		return nil, errors.NewUnexpectedError(err)
+	}
+	return toDomain(&model)
+}
+
+func (r *mediaRepository) ListByStory(ctx context.Context, storyID string) ([]*domain.Media, error) {
+	var models []*mediaModel
+	storyIDUint, _ := strconv.ParseUint(storyID, 10, 64)
+	err := r.db.WithContext(ctx).
+		Where("story_id = ?", uint(storyIDUint)).
+		Order("id ASC").
+		Find(&models).Error
+	if err != nil {
+		if isTransientError(err) {
+			return nil, errors.NewTransientError(err)
+		}
+		return nil, errors.NewUnexpectedError(err)
+	}
+
+	media := make([]*domain.Media, len(models))
+	for i, model := range models {
+		m, err := toDomain(model)
+		if err != nil {
+			return nil, err
+		}
+		media[i] = m
+	}
+	return media, nil
+}
+
+func (r *mediaRepository) Delete(ctx context.Context, id string) error {
+	idUint, _ := strconv.ParseUint(id, 10, 64)
+	if err := r.db.WithContext(ctx).Delete(&mediaModel{}, uint(idUint)).Error; err != nil {
+		if isTransientError(err) {
+			return errors.NewTransientError(err)
+		}
+		return errors.NewUnexpectedError(err)
+	}
+	return nil
+}
+
+// DeleteByStory removes all media records of the story
+func (r *mediaRepository) DeleteByStory(ctx context.Context, storyID uint) error {
+	if err := r.db.WithContext(ctx).Where("story_id = ?", storyID).Delete(&mediaModel{}).Error; err != nil {
+		if isTransientError(err) {
+			return errors.NewTransientError(err)
+		}
+		return errors.NewUnexpectedError(err)
+	}
+	return nil
+}
+
+// toModel converts domain media to database model
+func toModel(media *domain.Media) (*mediaModel, error) {
+	thumbnails, err := json.Marshal(media.Thumbnails)
+	if err != nil {
+		return nil, err
+	}
+	return &mediaModel{
+		ID:          media.ID,
+		StoryID:     media.StoryID,
+		Kind:        string(media.Kind),
+		ContentType: media.ContentType,
+		Size:        media.Size,
+		Width:       media.Width,
+		Height:      media.Height,
+		StorageKey:  media.StorageKey,
+		Thumbnails:  string(thumbnails),
+		CreatedAt:   media.CreatedAt,
+	}, nil
+}
+
+// toDomain converts database model to domain media
+func toDomain(model *mediaModel) (*domain.Media, error) {
+	thumbnails := make(map[string]string)
+	if model.Thumbnails != "" {
+		if err := json.Unmarshal([]byte(model.Thumbnails), &thumbnails); err != nil {
+			return nil, errors.NewUnexpectedError(err)
+		}
+	}
+	return &domain.Media{
+		ID:          model.ID,
+		StoryID:     model.StoryID,
+		Kind:        domain.Kind(model.Kind),
+		ContentType: model.ContentType,
+		Size:        model.Size,
+		Width:       model.Width,
+		Height:      model.Height,
+		StorageKey:  model.StorageKey,
+		Thumbnails:  thumbnails,
+		CreatedAt:   model.CreatedAt,
+	}, nil
+}
+
+func isTransientError(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	if !stderrors.As(err, &mysqlErr) {
+		return false
+	}
+	// Common MySQL transient error codes
+	switch mysqlErr.Number {
+	case 1213, // Deadlock
+		1205, // Lock wait timeout
+		2006, // MySQL server has gone away
+		2013: // Lost connection to MySQL server
+		return true
+	}
+	return false
+}
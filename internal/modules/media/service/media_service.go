@@ -0,0 +1,287 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"go-monolith/internal/modules/media/domain"
+	"go-monolith/internal/modules/media/repository"
+	storydomain "go-monolith/internal/modules/story/domain"
+	"go-monolith/pkg/errors"
+	"go-monolith/pkg/events"
+	"go-monolith/pkg/logger"
+	"go-monolith/pkg/metrics"
+	"go-monolith/pkg/storage"
+)
+
+type MediaService struct {
+	repo           repository.MediaRepository
+	store          storage.BlobStore
+	maxUploadBytes int64
+	maxDimension   int
+	logger         logger.Logger
+	metrics        *metrics.Client
+}
+
+func NewMediaService(repo repository.MediaRepository, store storage.BlobStore, maxUploadBytes int64, maxDimension int, logger logger.Logger, metrics *metrics.Client) *MediaService {
+	return &MediaService{
+		repo:           repo,
+		store:          store,
+		maxUploadBytes: maxUploadBytes,
+		maxDimension:   maxDimension,
+		logger:         logger,
+		metrics:        metrics,
+	}
+}
+
+// Event Handlers
+
+// HandleStoryDeleted removes the media of a deleted story, blobs and thumbnails included
+func (s *MediaService) HandleStoryDeleted(ctx context.Context, event events.Event) {
+	deleted, ok := event.(storydomain.StoryDeleted)
+	if !ok {
+		return
+	}
+	storyID := fmt.Sprintf("%d", deleted.StoryID)
+
+	media, err := s.repo.ListByStory(ctx, storyID)
+	if err == nil {
+		err = s.repo.DeleteByStory(ctx, deleted.StoryID)
+	}
+	if err != nil {
+		s.logger.Error(ctx, "Failed to delete media of story",
+			logger.String("error", err.Error()),
+			logger.String("story_id", storyID))
+		s.metrics.IncrementCounter("media.delete.error", []string{
+			"error_type:repository",
+		})
+		return
+	}
+
+	// Blobs are removed once nothing refers to them any more
+	var keys []string
+	for _, m := range media {
+		keys = append(keys, m.StorageKey)
+		for _, key := range m.Thumbnails {
+			keys = append(keys, key)
+		}
+	}
+	s.deleteBlobs(ctx, keys)
+}
+
+// Write Operations (Commands)
+
+// Upload validates an image, stores it with its thumbnails and records it against the story
+func (s *MediaService) Upload(ctx context.Context, storyID, kind string, r io.Reader) (*domain.Media, error) {
+	start := time.Now()
+	s.logger.Info(ctx, "Uploading story media",
+		logger.String("story_id", storyID),
+		logger.String("kind", kind))
+
+	// Record media upload attempt
+	s.metrics.IncrementCounter("media.upload.attempt", []string{
+		"story_id:" + storyID,
+		"kind:" + kind,
+	})
+
+	// Read one byte past the limit so oversized uploads can be rejected without buffering them fully
+	data, err := io.ReadAll(io.LimitReader(r, s.maxUploadBytes+1))
+	if err != nil {
+		s.logger.Error(ctx, "Failed to read uploaded media",
+			logger.String("error", err.Error()),
+			logger.String("story_id", storyID))
+		s.metrics.IncrementCounter("media.upload.error", []string{
+			"story_id:" + storyID,
+			"error_type:read",
+		})
+		return nil, errors.NewValidationError("failed to read uploaded file")
+	}
+
+	contentType := http.DetectContentType(data)
+	var width, height int
+	var img image.Image
+	if domain.IsAllowedContentType(contentType) && int64(len(data)) <= s.maxUploadBytes {
+		img, err = s.decode(data)
+		if err != nil {
+			s.metrics.IncrementCounter("media.upload.error", []string{
+				"story_id:" + storyID,
+				"error_type:validation",
+			})
+			return nil, err
+		}
+		width, height = img.Bounds().Dx(), img.Bounds().Dy()
+	}
+
+	media, err := domain.NewMedia(storyID, kind, contentType, int64(len(data)), s.maxUploadBytes, width, height)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to create media",
+			logger.String("error", err.Error()),
+			logger.String("story_id", storyID),
+			logger.String("content_type", contentType))
+		// Record validation error
+		s.metrics.IncrementCounter("media.upload.error", []string{
+			"story_id:" + storyID,
+			"error_type:validation",
+		})
+		return nil, err
+	}
+
+	base := fmt.Sprintf("stories/%s/%s", storyID, uuid.New().String())
+	media.StorageKey = base + media.Extension()
+	stored := []string{media.StorageKey}
+
+	if err := s.store.Put(ctx, media.StorageKey, bytes.NewReader(data), contentType); err != nil {
+		return nil, s.storageFailure(ctx, storyID, err, stored)
+	}
+
+	thumbnails, err := generateThumbnails(img, contentType, domain.ThumbnailSizes)
+	if err != nil {
+		return nil, s.storageFailure(ctx, storyID, err, stored)
+	}
+	for _, thumb := range thumbnails {
+		key := fmt.Sprintf("%s_%s%s", base, thumb.name, extensionFor(thumb.contentType))
+		if err := s.store.Put(ctx, key, bytes.NewReader(thumb.data), thumb.contentType); err != nil {
+			return nil, s.storageFailure(ctx, storyID, err, stored)
+		}
+		stored = append(stored, key)
+		media.Thumbnails[thumb.name] = key
+	}
+
+	if err := s.repo.Create(ctx, media); err != nil {
+		s.logger.Error(ctx, "Failed to save media to repository",
+			logger.String("error", err.Error()),
+			logger.String("story_id", storyID))
+		// Record repository error
+		s.metrics.IncrementCounter("media.upload.error", []string{
+			"story_id:" + storyID,
+			"error_type:repository",
+		})
+		s.deleteBlobs(ctx, stored)
+		return nil, err
+	}
+
+	s.logger.Info(ctx, "Story media uploaded successfully",
+		logger.String("media_id", fmt.Sprintf("%d", media.ID)),
+		logger.String("story_id", storyID))
+
+	// Record successful upload
+	s.metrics.IncrementCounter("media.upload.success", []string{
+		"story_id:" + storyID,
+		"kind:" + kind,
+	})
+
+	// Record operation duration
+	duration := time.Since(start)
+	s.metrics.RecordTiming("media.upload.duration", duration, []string{
+		"kind:" + kind,
+	})
+
+	s.resolveURLs(media)
+	return media, nil
+}
+
+// Read Operations (Queries)
+
+// GetCoverImage returns the most recently uploaded cover image of a story
+func (s *MediaService) GetCoverImage(ctx context.Context, storyID string) (*domain.Media, error) {
+	s.logger.Debug(ctx, "Getting story cover image", logger.String("story_id", storyID))
+
+	media, err := s.repo.GetLatestByStory(ctx, storyID, domain.KindCover)
+	if err != nil {
+		if kind, ok := errors.KindOf(err); ok && kind == errors.ErrKindNotFound {
+			return nil, err
+		}
+		s.logger.Error(ctx, "Failed to get story cover image",
+			logger.String("error", err.Error()),
+			logger.String("story_id", storyID))
+		s.metrics.IncrementCounter("media.fetch.error", []string{
+			"story_id:" + storyID,
+			"error_type:repository",
+		})
+		return nil, err
+	}
+
+	s.resolveURLs(media)
+	return media, nil
+}
+
+// ListByStory returns all media attached to a story in upload order
+func (s *MediaService) ListByStory(ctx context.Context, storyID string) ([]*domain.Media, error) {
+	media, err := s.repo.ListByStory(ctx, storyID)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to list story media",
+			logger.String("error", err.Error()),
+			logger.String("story_id", storyID))
+		return nil, err
+	}
+	for _, m := range media {
+		s.resolveURLs(m)
+	}
+	return media, nil
+}
+
+// resolveURLs fills in the public URLs of the media and its thumbnails
+func (s *MediaService) resolveURLs(media *domain.Media) {
+	media.URL = s.store.URL(media.StorageKey)
+	media.ThumbnailURLs = make(map[string]string, len(media.Thumbnails))
+	for name, key := range media.Thumbnails {
+		media.ThumbnailURLs[name] = s.store.URL(key)
+	}
+}
+
+// decode reads the image header first so that images too large to hold in memory
+// once decoded are rejected before their pixels are allocated
+func (s *MediaService) decode(data []byte) (image.Image, error) {
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, domain.NewInvalidImageError()
+	}
+	if config.Width > s.maxDimension || config.Height > s.maxDimension {
+		return nil, domain.NewImageTooLargeError(s.maxDimension)
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, domain.NewInvalidImageError()
+	}
+	return img, nil
+}
+
+// storageFailure logs a blob store error, cleans up anything already written and wraps the error
+func (s *MediaService) storageFailure(ctx context.Context, storyID string, err error, stored []string) error {
+	s.logger.Error(ctx, "Failed to store media",
+		logger.String("error", err.Error()),
+		logger.String("story_id", storyID))
+	s.metrics.IncrementCounter("media.upload.error", []string{
+		"story_id:" + storyID,
+		"error_type:storage",
+	})
+	s.deleteBlobs(ctx, stored)
+	return errors.NewUnexpectedError(err)
+}
+
+func (s *MediaService) deleteBlobs(ctx context.Context, keys []string) {
+	for _, key := range keys {
+		if err := s.store.Delete(ctx, key); err != nil {
+			s.logger.Warn(ctx, "Failed to clean up media blob",
+				logger.String("key", key),
+				logger.String("error", err.Error()))
+		}
+	}
+}
+
+func extensionFor(contentType string) string {
+	if contentType == "image/jpeg" {
+		return ".jpg"
+	}
+	return ".png"
+}
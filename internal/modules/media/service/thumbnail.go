@@ -0,0 +1,99 @@
+package service
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+)
+
+// thumbnail is an encoded, resized copy of an uploaded image
+type thumbnail struct {
+	name        string
+	contentType string
+	data        []byte
+}
+
+// generateThumbnails produces a resized copy of img for every configured size
+// that is smaller than the original. JPEGs stay JPEG, everything else becomes PNG.
+func generateThumbnails(img image.Image, contentType string, sizes map[string]int) ([]thumbnail, error) {
+	bounds := img.Bounds()
+	thumbnails := make([]thumbnail, 0, len(sizes))
+
+	for name, width := range sizes {
+		if width >= bounds.Dx() {
+			continue
+		}
+		height := bounds.Dy() * width / bounds.Dx()
+		if height < 1 {
+			height = 1
+		}
+
+		resized := resize(img, width, height)
+
+		var buf bytes.Buffer
+		thumbType := "image/png"
+		var err error
+		if contentType == "image/jpeg" {
+			thumbType = "image/jpeg"
+			err = jpeg.Encode(&buf, resized, &jpeg.Options{Quality: 85})
+		} else {
+			err = png.Encode(&buf, resized)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		thumbnails = append(thumbnails, thumbnail{
+			name:        name,
+			contentType: thumbType,
+			data:        buf.Bytes(),
+		})
+	}
+
+	return thumbnails, nil
+}
+
+// resize scales src down to width x height by averaging the source pixels
+// covered by each destination pixel (box filter)
+func resize(src image.Image, width, height int) image.Image {
+	bounds := src.Bounds()
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+
+	for y := 0; y < height; y++ {
+		y0 := bounds.Min.Y + y*bounds.Dy()/height
+		y1 := bounds.Min.Y + (y+1)*bounds.Dy()/height
+		if y1 <= y0 {
+			y1 = y0 + 1
+		}
+		for x := 0; x < width; x++ {
+			x0 := bounds.Min.X + x*bounds.Dx()/width
+			x1 := bounds.Min.X + (x+1)*bounds.Dx()/width
+			if x1 <= x0 {
+				x1 = x0 + 1
+			}
+
+			var r, g, b, a, n uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					pr, pg, pb, pa := src.At(sx, sy).RGBA()
+					r += uint64(pr)
+					g += uint64(pg)
+					b += uint64(pb)
+					a += uint64(pa)
+					n++
+				}
+			}
+
+			dst.Set(x, y, color.RGBA64{
+				R: uint16(r / n),
+				G: uint16(g / n),
+				B: uint16(b / n),
+				A: uint16(a / n),
+			})
+		}
+	}
+
+	return dst
+}
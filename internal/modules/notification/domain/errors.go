@@ -0,0 +1,24 @@
+package domain
+
+import (
+	"go-monolith/pkg/errors"
+)
+
+// NotificationError represents notification-specific domain errors
+type NotificationError struct {
+	errors.BaseError
+}
+
+func NewNotificationError(message string) error {
+	return &NotificationError{
+		BaseError: errors.BaseError{
+			Kind:    errors.ErrKindValidation,
+			Message: message,
+		},
+	}
+}
+
+// Domain-specific error constructors
+func NewNotificationNotFoundError(id string) error {
+	return errors.NewNotFoundError("notification", id)
+}
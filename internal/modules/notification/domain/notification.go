@@ -0,0 +1,96 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// Type identifies what a notification is about
+type Type string
+
+const (
+	TypeStoryPublished Type = "story_published"
+	TypeStoryLiked     Type = "story_liked"
+	TypeStoryCommented Type = "story_commented"
+)
+
+const (
+	// DefaultPageSize is the number of notifications returned when no limit is given
+	DefaultPageSize = 20
+	// MaxPageSize caps a page of notifications
+	MaxPageSize = 100
+)
+
+// Notification tells a user that something happened to a story they care about.
+// Notifications of the same type about the same story are grouped while unread,
+// so many likes produce one "5 people liked your story" entry.
+type Notification struct {
+	ID         uint
+	UserID     string
+	Type       Type
+	GroupKey   string
+	StoryID    uint
+	AuthorID   uint
+	StoryTitle string
+	ActorCount int
+	Read       bool
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// NewNotification creates an unread notification for the user
+func NewNotification(userID string, t Type, storyID, authorID uint, storyTitle string) (*Notification, error) {
+	if userID == "" {
+		return nil, NewNotificationError("user ID cannot be empty")
+	}
+	switch t {
+	case TypeStoryPublished, TypeStoryLiked, TypeStoryCommented:
+	default:
+		return nil, NewNotificationError(fmt.Sprintf("invalid notification type '%s'", t))
+	}
+
+	now := time.Now()
+	return &Notification{
+		UserID:     userID,
+		Type:       t,
+		GroupKey:   GroupKey(t, storyID),
+		StoryID:    storyID,
+		AuthorID:   authorID,
+		StoryTitle: storyTitle,
+		ActorCount: 1,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}, nil
+}
+
+// GroupKey returns the key unread notifications are grouped by
+func GroupKey(t Type, storyID uint) string {
+	return fmt.Sprintf("%s:%d", t, storyID)
+}
+
+// Message renders the notification for display
+func (n *Notification) Message() string {
+	title := n.StoryTitle
+	if title == "" {
+		title = "your story"
+	} else {
+		title = fmt.Sprintf("\"%s\"", title)
+	}
+
+	switch n.Type {
+	case TypeStoryPublished:
+		return fmt.Sprintf("An author you follow published %s", title)
+	case TypeStoryLiked:
+		return fmt.Sprintf("%s liked %s", actors(n.ActorCount), title)
+	case TypeStoryCommented:
+		return fmt.Sprintf("%s commented on %s", actors(n.ActorCount), title)
+	}
+	return ""
+}
+
+func actors(count int) string {
+	if count <= 1 {
+		return "Someone"
+	}
+	return fmt.Sprintf("%d people", count)
+}
@@ -0,0 +1,32 @@
+package notification
+
+import (
+	"gorm.io/gorm"
+
+	authordomain "go-monolith/internal/modules/author/domain"
+	"go-monolith/internal/modules/notification/repository"
+	"go-monolith/internal/modules/notification/service"
+	storydomain "go-monolith/internal/modules/story/domain"
+	"go-monolith/pkg/events"
+	"go-monolith/pkg/logger"
+	"go-monolith/pkg/metrics"
+)
+
+type Module struct {
+	NotificationService *service.NotificationService
+}
+
+func NewModule(db *gorm.DB, bus *events.Bus, audience service.AudienceResolver, logger logger.Logger, metrics *metrics.Client) *Module {
+	repo := repository.NewNotificationRepository(db)
+	notificationService := service.NewNotificationService(repo, audience, logger, metrics)
+
+	// Create notifications from story and author events and drop those about deleted ones
+	bus.Subscribe(storydomain.EventStoryPublished, notificationService.HandleStoryPublished)
+	bus.Subscribe(storydomain.EventStoryEngaged, notificationService.HandleStoryEngaged)
+	bus.Subscribe(storydomain.EventStoryDeleted, notificationService.HandleStoryDeleted)
+	bus.Subscribe(authordomain.EventAuthorDeleted, notificationService.HandleAuthorDeleted)
+
+	return &Module{
+		NotificationService: notificationService,
+	}
+}
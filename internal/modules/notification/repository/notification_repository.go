@@ -0,0 +1,307 @@
+package repository
+
+import (
+	"context"
+	stderrors "errors"
+	"strconv"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"go-monolith/internal/modules/notification/domain"
+	"go-monolith/pkg/errors"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+// notificationModel represents the database model
+type notificationModel struct {
+	ID         uint      `gorm:"primaryKey;autoIncrement"`
+	UserID     string    `gorm:"type:varchar(64);not null;index:idx_notifications_user_recent,priority:1;index:idx_notifications_user_group,priority:1"`
+	Type       string    `gorm:"type:varchar(32);not null"`
+	GroupKey   string    `gorm:"type:varchar(64);not null;index:idx_notifications_user_group,priority:2"`
+	StoryID    uint      `gorm:"not null"`
+	AuthorID   uint      `gorm:"not null;index"`
+	StoryTitle string    `gorm:"type:varchar(255);not null;default:''"`
+	ActorCount int       `gorm:"not null;default:1"`
+	IsRead     bool      `gorm:"not null;default:false"`
+	CreatedAt  time.Time `gorm:"not null;default:CURRENT_TIMESTAMP"`
+	UpdatedAt  time.Time `gorm:"not null;index:idx_notifications_user_recent,priority:2"`
+}
+
+// TableName sets the insert table name for this struct type
+func (notificationModel) TableName() string {
+	return "notifications"
+}
+
+// notificationActorModel records each distinct user counted in a grouped notification
+type notificationActorModel struct {
+	NotificationID uint   `gorm:"primaryKey"`
+	ActorID        string `gorm:"primaryKey;type:varchar(64)"`
+}
+
+// TableName sets the insert table name for this struct type
+func (notificationActorModel) TableName() string {
+	return "notification_actors"
+}
+
+// insertBatchSize bounds the number of notifications stored per INSERT statement
+const insertBatchSize = 500
+
+// In this context, Only benefit of using interface is to allow for mocking in tests, otherwise not needed
+type NotificationRepository interface {
+	Upsert(ctx context.Context, notification *domain.Notification, actorID string) error
+	CreateMany(ctx context.Context, notifications []*domain.Notification) error
+	ListByUser(ctx context.Context, userID string, unreadOnly bool, limit, offset int) ([]*domain.Notification, error)
+	CountUnread(ctx context.Context, userID string) (int64, error)
+	MarkRead(ctx context.Context, userID string, id uint) error
+	MarkAllRead(ctx context.Context, userID string) (int64, error)
+	DeleteByAuthor(ctx context.Context, authorID uint) error
+	DeleteByStory(ctx context.Context, storyID uint) error
+}
+
+type notificationRepository struct {
+	db *gorm.DB
+}
+
+func NewNotificationRepository(db *gorm.DB) NotificationRepository {
+	return &notificationRepository{db: db}
+}
+
+// Upsert folds the notification into the user's unread notification with the same
+// group key, counting each actor once, or stores it as a new notification.
+func (r *notificationRepository) Upsert(ctx context.Context, notification *domain.Notification, actorID string) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var existing notificationModel
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("user_id = ? AND group_key = ? AND is_read = ?", notification.UserID, notification.GroupKey, false).
+			First(&existing).Error
+		if err != nil && err != gorm.ErrRecordNotFound {
+			return err
+		}
+
+		if err == gorm.ErrRecordNotFound {
+			model := toModel(notification)
+			if err := tx.Create(model).Error; err != nil {
+				return err
+			}
+			notification.ID = model.ID
+			if actorID == "" {
+				return nil
+			}
+			return tx.Create(&notificationActorModel{NotificationID: model.ID, ActorID: actorID}).Error
+		}
+
+		notification.ID = existing.ID
+		notification.CreatedAt = existing.CreatedAt
+		notification.ActorCount = existing.ActorCount
+		if actorID != "" {
+			result := tx.Clauses(clause.OnConflict{DoNothing: true}).
+				Create(&notificationActorModel{NotificationID: existing.ID, ActorID: actorID})
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				// The actor is already counted in this group
+				return nil
+			}
+		}
+
+		notification.ActorCount++
+		return tx.Model(&notificationModel{}).
+			Where("id = ?", existing.ID).
+			Updates(map[string]interface{}{
+				"actor_count": notification.ActorCount,
+				"story_title": notification.StoryTitle,
+				"updated_at":  notification.UpdatedAt,
+			}).Error
+	})
+	if err != nil {
+		return wrapError(err)
+	}
+	return nil
+}
+
+// CreateMany stores new notifications with multi-row inserts. Unlike Upsert it does
+// not group them with unread ones, so it suits notifications that have no group yet,
+// such as those about a story being published.
+func (r *notificationRepository) CreateMany(ctx context.Context, notifications []*domain.Notification) error {
+	if len(notifications) == 0 {
+		return nil
+	}
+	models := make([]*notificationModel, len(notifications))
+	for i, notification := range notifications {
+		models[i] = toModel(notification)
+	}
+	if err := r.db.WithContext(ctx).CreateInBatches(models, insertBatchSize).Error; err != nil {
+		return wrapError(err)
+	}
+	for i, model := range models {
+		notifications[i].ID = model.ID
+	}
+	return nil
+}
+
+// ListByUser returns the user's notifications, most recently updated first
+func (r *notificationRepository) ListByUser(ctx context.Context, userID string, unreadOnly bool, limit, offset int) ([]*domain.Notification, error) {
+	var models []*notificationModel
+	query := r.db.WithContext(ctx).Where("user_id = ?", userID)
+	if unreadOnly {
+		query = query.Where("is_read = ?", false)
+	}
+	err := query.
+		Order("updated_at DESC, id DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&models).Error
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	notifications := make([]*domain.Notification, len(models))
+	for i, model := range models {
+		notifications[i] = toDomain(model)
+	}
+	return notifications, nil
+}
+
+func (r *notificationRepository) CountUnread(ctx context.Context, userID string) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&notificationModel{}).
+		Where("user_id = ? AND is_read = ?", userID, false).
+		Count(&count).Error
+	if err != nil {
+		return 0, wrapError(err)
+	}
+	return count, nil
+}
+
+// MarkRead marks one of the user's notifications as read
+func (r *notificationRepository) MarkRead(ctx context.Context, userID string, id uint) error {
+	var model notificationModel
+	err := r.db.WithContext(ctx).
+		Where("id = ? AND user_id = ?", id, userID).
+		First(&model).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return domain.NewNotificationNotFoundError(strconv.FormatUint(uint64(id), 10))
+		}
+		return wrapError(err)
+	}
+	if model.IsRead {
+		return nil
+	}
+
+	err = r.db.WithContext(ctx).
+		Model(&notificationModel{}).
+		Where("id = ?", id).
+		UpdateColumn("is_read", true).Error
+	if err != nil {
+		return wrapError(err)
+	}
+	return nil
+}
+
+// MarkAllRead marks all unread notifications of the user as read and returns how many changed
+func (r *notificationRepository) MarkAllRead(ctx context.Context, userID string) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Model(&notificationModel{}).
+		Where("user_id = ? AND is_read = ?", userID, false).
+		UpdateColumn("is_read", true)
+	if result.Error != nil {
+		return 0, wrapError(result.Error)
+	}
+	return result.RowsAffected, nil
+}
+
+// DeleteByAuthor removes all notifications about the author's stories
+func (r *notificationRepository) DeleteByAuthor(ctx context.Context, authorID uint) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		ids := tx.Model(&notificationModel{}).Select("id").Where("author_id = ?", authorID)
+		if err := tx.Where("notification_id IN (?)", ids).Delete(&notificationActorModel{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("author_id = ?", authorID).Delete(&notificationModel{}).Error
+	})
+	if err != nil {
+		return wrapError(err)
+	}
+	return nil
+}
+
+// DeleteByStory removes all notifications about the story
+func (r *notificationRepository) DeleteByStory(ctx context.Context, storyID uint) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		ids := tx.Model(&notificationModel{}).Select("id").Where("story_id = ?", storyID)
+		if err := tx.Where("notification_id IN (?)", ids).Delete(&notificationActorModel{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("story_id = ?", storyID).Delete(&notificationModel{}).Error
+	})
+	if err != nil {
+		return wrapError(err)
+	}
+	return nil
+}
+
+// toModel converts domain notification to database model
+func toModel(n *domain.Notification) *notificationModel {
+	return &notificationModel{
+		ID:         n.ID,
+		UserID:     n.UserID,
+		Type:       string(n.Type),
+		GroupKey:   n.GroupKey,
+		StoryID:    n.StoryID,
+		AuthorID:   n.AuthorID,
+		StoryTitle: n.StoryTitle,
+		ActorCount: n.ActorCount,
+		IsRead:     n.Read,
+		CreatedAt:  n.CreatedAt,
+		UpdatedAt:  n.UpdatedAt,
+	}
+}
+
+// toDomain converts database model to domain notification
+func toDomain(model *notificationModel) *domain.Notification {
+	return &domain.Notification{
+		ID:         model.ID,
+		UserID:     model.UserID,
+		Type:       domain.Type(model.Type),
+		GroupKey:   model.GroupKey,
+		StoryID:    model.StoryID,
+		AuthorID:   model.AuthorID,
+		StoryTitle: model.StoryTitle,
+		ActorCount: model.ActorCount,
+		Read:       model.IsRead,
+		CreatedAt:  model.CreatedAt,
+		UpdatedAt:  model.UpdatedAt,
+	}
+}
+
+func wrapError(err error) error {
+	if _, ok := errors.KindOf(err); ok {
+		return err
+	}
+	if isTransientError(err) {
+		return errors.NewTransientError(err)
+	}
+	return errors.NewUnexpectedError(err)
+}
+
+func isTransientError(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	if !stderrors.As(err, &mysqlErr) {
+		return false
+	}
+	// Common MySQL transient error codes
+	switch mysqlErr.Number {
+	case 1213, // Deadlock
+		1205, // Lock wait timeout
+		2006, // MySQL server has gone away
+		2013: // Lost connection to MySQL server
+		return true
+	}
+	return false
+}
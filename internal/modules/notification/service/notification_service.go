@@ -0,0 +1,268 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	authordomain "go-monolith/internal/modules/author/domain"
+	"go-monolith/internal/modules/notification/domain"
+	"go-monolith/internal/modules/notification/repository"
+	storydomain "go-monolith/internal/modules/story/domain"
+	"go-monolith/pkg/errors"
+	"go-monolith/pkg/events"
+	"go-monolith/pkg/logger"
+	"go-monolith/pkg/metrics"
+)
+
+// AudienceResolver finds the users to notify about an author's stories
+type AudienceResolver interface {
+	// Followers returns the users following the author
+	Followers(ctx context.Context, authorID uint) ([]string, error)
+	// Owners returns the users that manage the author
+	Owners(ctx context.Context, authorID uint) ([]string, error)
+}
+
+type NotificationService struct {
+	repo     repository.NotificationRepository
+	audience AudienceResolver
+	logger   logger.Logger
+	metrics  *metrics.Client
+}
+
+func NewNotificationService(repo repository.NotificationRepository, audience AudienceResolver, logger logger.Logger, metrics *metrics.Client) *NotificationService {
+	return &NotificationService{
+		repo:     repo,
+		audience: audience,
+		logger:   logger,
+		metrics:  metrics,
+	}
+}
+
+// Event Handlers
+
+// HandleStoryPublished notifies the author's followers about a new story
+func (s *NotificationService) HandleStoryPublished(ctx context.Context, event events.Event) {
+	published, ok := event.(storydomain.StoryPublished)
+	if !ok {
+		return
+	}
+
+	followers, err := s.audience.Followers(ctx, published.AuthorID)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to resolve followers for notification",
+			logger.String("error", err.Error()),
+			logger.String("author_id", fmt.Sprintf("%d", published.AuthorID)))
+		s.metrics.IncrementCounter("notification.create.error", []string{
+			"type:" + string(domain.TypeStoryPublished),
+			"error_type:audience",
+		})
+		return
+	}
+
+	// A story is published once, so its notifications are new and are inserted in
+	// batches rather than upserted one follower at a time
+	notifications := make([]*domain.Notification, 0, len(followers))
+	for _, userID := range followers {
+		notification, err := domain.NewNotification(userID, domain.TypeStoryPublished, published.StoryID, published.AuthorID, published.Title)
+		if err != nil {
+			s.metrics.IncrementCounter("notification.create.error", []string{
+				"type:" + string(domain.TypeStoryPublished),
+				"error_type:validation",
+			})
+			continue
+		}
+		notifications = append(notifications, notification)
+	}
+
+	if err := s.repo.CreateMany(ctx, notifications); err != nil {
+		s.logger.Error(ctx, "Failed to save notifications",
+			logger.String("error", err.Error()),
+			logger.String("type", string(domain.TypeStoryPublished)),
+			logger.String("story_id", fmt.Sprintf("%d", published.StoryID)),
+			logger.Int("followers", len(notifications)))
+		s.metrics.IncrementCounter("notification.create.error", []string{
+			"type:" + string(domain.TypeStoryPublished),
+			"error_type:repository",
+		})
+		return
+	}
+	s.metrics.IncrementCounter("notification.create.success", []string{
+		"type:" + string(domain.TypeStoryPublished),
+	})
+}
+
+// HandleStoryEngaged notifies the story's owners about likes and comments
+func (s *NotificationService) HandleStoryEngaged(ctx context.Context, event events.Event) {
+	engaged, ok := event.(storydomain.StoryEngaged)
+	if !ok {
+		return
+	}
+
+	var t domain.Type
+	switch engaged.Kind {
+	case storydomain.EngagementLike:
+		t = domain.TypeStoryLiked
+	case storydomain.EngagementComment:
+		t = domain.TypeStoryCommented
+	default:
+		return
+	}
+	if engaged.UserID == "" {
+		return
+	}
+
+	owners, err := s.audience.Owners(ctx, engaged.AuthorID)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to resolve owners for notification",
+			logger.String("error", err.Error()),
+			logger.String("author_id", fmt.Sprintf("%d", engaged.AuthorID)))
+		s.metrics.IncrementCounter("notification.create.error", []string{
+			"type:" + string(t),
+			"error_type:audience",
+		})
+		return
+	}
+
+	for _, userID := range owners {
+		// Users are not notified about their own activity
+		if userID == engaged.UserID {
+			continue
+		}
+		s.notify(ctx, userID, t, engaged.StoryID, engaged.AuthorID, engaged.Title, engaged.UserID)
+	}
+}
+
+// HandleAuthorDeleted removes notifications about a deleted author's stories
+func (s *NotificationService) HandleAuthorDeleted(ctx context.Context, event events.Event) {
+	deleted, ok := event.(authordomain.AuthorDeleted)
+	if !ok {
+		return
+	}
+
+	if err := s.repo.DeleteByAuthor(ctx, deleted.AuthorID); err != nil {
+		s.logger.Error(ctx, "Failed to delete notifications of author",
+			logger.String("error", err.Error()),
+			logger.String("author_id", fmt.Sprintf("%d", deleted.AuthorID)))
+		s.metrics.IncrementCounter("notification.delete.error", nil)
+	}
+}
+
+// HandleStoryDeleted removes notifications about a deleted story
+func (s *NotificationService) HandleStoryDeleted(ctx context.Context, event events.Event) {
+	deleted, ok := event.(storydomain.StoryDeleted)
+	if !ok {
+		return
+	}
+
+	if err := s.repo.DeleteByStory(ctx, deleted.StoryID); err != nil {
+		s.logger.Error(ctx, "Failed to delete notifications of story",
+			logger.String("error", err.Error()),
+			logger.String("story_id", fmt.Sprintf("%d", deleted.StoryID)))
+		s.metrics.IncrementCounter("notification.delete.error", nil)
+	}
+}
+
+// notify stores a notification, grouping it with the user's unread ones of the same kind
+func (s *NotificationService) notify(ctx context.Context, userID string, t domain.Type, storyID, authorID uint, title, actorID string) {
+	notification, err := domain.NewNotification(userID, t, storyID, authorID, title)
+	if err != nil {
+		s.metrics.IncrementCounter("notification.create.error", []string{
+			"type:" + string(t),
+			"error_type:validation",
+		})
+		return
+	}
+
+	if err := s.repo.Upsert(ctx, notification, actorID); err != nil {
+		s.logger.Error(ctx, "Failed to save notification",
+			logger.String("error", err.Error()),
+			logger.String("type", string(t)),
+			logger.String("story_id", fmt.Sprintf("%d", storyID)))
+		s.metrics.IncrementCounter("notification.create.error", []string{
+			"type:" + string(t),
+			"error_type:repository",
+		})
+		return
+	}
+
+	s.metrics.IncrementCounter("notification.create.success", []string{
+		"type:" + string(t),
+	})
+}
+
+// Write Operations (Commands)
+
+// MarkRead marks one of the user's notifications as read
+func (s *NotificationService) MarkRead(ctx context.Context, userID, id string) error {
+	idUint, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return errors.NewValidationError("invalid notification ID format")
+	}
+
+	if err := s.repo.MarkRead(ctx, userID, uint(idUint)); err != nil {
+		return err
+	}
+	s.metrics.IncrementCounter("notification.read.success", nil)
+	return nil
+}
+
+// MarkAllRead marks all of the user's notifications as read
+func (s *NotificationService) MarkAllRead(ctx context.Context, userID string) (int64, error) {
+	count, err := s.repo.MarkAllRead(ctx, userID)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to mark notifications as read",
+			logger.String("error", err.Error()))
+		s.metrics.IncrementCounter("notification.read.error", []string{
+			"error_type:repository",
+		})
+		return 0, err
+	}
+	s.metrics.IncrementCounter("notification.read.success", nil)
+	return count, nil
+}
+
+// Read Operations (Queries)
+
+// List returns a page of the user's notifications, most recent first
+func (s *NotificationService) List(ctx context.Context, userID string, unreadOnly bool, limit, offset int) ([]*domain.Notification, error) {
+	start := time.Now()
+	if limit <= 0 {
+		limit = domain.DefaultPageSize
+	}
+	if limit > domain.MaxPageSize {
+		limit = domain.MaxPageSize
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	notifications, err := s.repo.ListByUser(ctx, userID, unreadOnly, limit, offset)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to list notifications",
+			logger.String("error", err.Error()))
+		s.metrics.IncrementCounter("notification.list.error", []string{
+			"error_type:repository",
+		})
+		return nil, err
+	}
+
+	s.metrics.IncrementCounter("notification.list.success", nil)
+	s.metrics.RecordTiming("notification.list.duration", time.Since(start), nil)
+	return notifications, nil
+}
+
+// UnreadCount returns the number of unread notifications of the user
+func (s *NotificationService) UnreadCount(ctx context.Context, userID string) (int64, error) {
+	count, err := s.repo.CountUnread(ctx, userID)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to count unread notifications",
+			logger.String("error", err.Error()))
+		s.metrics.IncrementCounter("notification.count.error", []string{
+			"error_type:repository",
+		})
+		return 0, err
+	}
+	return count, nil
+}
@@ -0,0 +1,32 @@
+package domain
+
+import (
+	"go-monolith/pkg/errors"
+)
+
+// ProgressError represents reading-progress-specific domain errors
+type ProgressError struct {
+	errors.BaseError
+}
+
+func NewProgressError(message string) error {
+	return &ProgressError{
+		BaseError: errors.BaseError{
+			Kind:    errors.ErrKindValidation,
+			Message: message,
+		},
+	}
+}
+
+// Domain-specific error constructors
+func NewProgressNotFoundError(storyID string) error {
+	return errors.NewNotFoundError("reading progress for story", storyID)
+}
+
+func NewInvalidPercentError() error {
+	return NewProgressError("percent must be between 0 and 100")
+}
+
+func NewInvalidOffsetError() error {
+	return NewProgressError("offset cannot be negative")
+}
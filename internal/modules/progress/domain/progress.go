@@ -0,0 +1,82 @@
+package domain
+
+import (
+	"strconv"
+	"time"
+)
+
+// FinishedPercent is the progress at which a story counts as read to the end
+const FinishedPercent = 100
+
+// ReadingProgress records how far a user has read into a story
+type ReadingProgress struct {
+	UserID     string
+	StoryID    uint
+	Percent    float64
+	Offset     int64
+	LastReadAt time.Time
+
+	// percent and offset are the values given to NewReadingProgress, nil when omitted
+	percent *float64
+	offset  *int64
+}
+
+// NewReadingProgress validates and creates a progress record.
+// Either percent or offset must be given; the other defaults to zero, and saving
+// the record leaves an omitted field of an existing one unchanged.
+func NewReadingProgress(userID, storyID string, percent *float64, offset *int64) (*ReadingProgress, error) {
+	if err := validateInputs(userID, storyID, percent, offset); err != nil {
+		return nil, err
+	}
+
+	storyIDUint, _ := strconv.ParseUint(storyID, 10, 64)
+	progress := &ReadingProgress{
+		UserID:     userID,
+		StoryID:    uint(storyIDUint),
+		LastReadAt: time.Now(),
+		percent:    percent,
+		offset:     offset,
+	}
+	if percent != nil {
+		progress.Percent = *percent
+	}
+	if offset != nil {
+		progress.Offset = *offset
+	}
+	return progress, nil
+}
+
+// HasPercent reports whether the percent was given rather than defaulted
+func (p *ReadingProgress) HasPercent() bool {
+	return p.percent != nil
+}
+
+// HasOffset reports whether the offset was given rather than defaulted
+func (p *ReadingProgress) HasOffset() bool {
+	return p.offset != nil
+}
+
+// IsFinished reports whether the user has reached the end of the story
+func (p *ReadingProgress) IsFinished() bool {
+	return p.Percent >= FinishedPercent
+}
+
+// validateInputs performs validation on raw progress inputs
+func validateInputs(userID, storyID string, percent *float64, offset *int64) error {
+	if userID == "" {
+		return NewProgressError("user ID cannot be empty")
+	}
+	if _, err := strconv.ParseUint(storyID, 10, 64); err != nil {
+		return NewProgressError("invalid story ID format")
+	}
+	if percent == nil && offset == nil {
+		return NewProgressError("either percent or offset is required")
+	}
+	if percent != nil && (*percent < 0 || *percent > FinishedPercent) {
+		return NewInvalidPercentError()
+	}
+	if offset != nil && *offset < 0 {
+		return NewInvalidOffsetError()
+	}
+	return nil
+}
@@ -0,0 +1,28 @@
+package progress
+
+import (
+	"gorm.io/gorm"
+
+	"go-monolith/internal/modules/progress/repository"
+	"go-monolith/internal/modules/progress/service"
+	storydomain "go-monolith/internal/modules/story/domain"
+	"go-monolith/pkg/events"
+	"go-monolith/pkg/logger"
+	"go-monolith/pkg/metrics"
+)
+
+type Module struct {
+	ProgressService *service.ProgressService
+}
+
+func NewModule(db *gorm.DB, bus *events.Bus, logger logger.Logger, metrics *metrics.Client) *Module {
+	repo := repository.NewProgressRepository(db)
+	progressService := service.NewProgressService(repo, logger, metrics)
+
+	// Drop reading progress in deleted stories
+	bus.Subscribe(storydomain.EventStoryDeleted, progressService.HandleStoryDeleted)
+
+	return &Module{
+		ProgressService: progressService,
+	}
+}
@@ -0,0 +1,170 @@
+package repository
+
+import (
+	"context"
+	stderrors "errors"
+	"strconv"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"go-monolith/internal/modules/progress/domain"
+	"go-monolith/pkg/errors"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+// progressModel represents the database model
+type progressModel struct {
+	UserID     string    `gorm:"primaryKey;type:varchar(64);index:idx_reading_progress_recent,priority:1"`
+	StoryID    uint      `gorm:"primaryKey"`
+	Percent    float64   `gorm:"not null;default:0"`
+	Offset     int64     `gorm:"column:char_offset;not null;default:0"`
+	LastReadAt time.Time `gorm:"not null;index:idx_reading_progress_recent,priority:2"`
+}
+
+// TableName sets the insert table name for this struct type
+func (progressModel) TableName() string {
+	return "reading_progress"
+}
+
+// In this context, Only benefit of using interface is to allow for mocking in tests, otherwise not needed
+type ProgressRepository interface {
+	Save(ctx context.Context, progress *domain.ReadingProgress) error
+	Get(ctx context.Context, userID, storyID string) (*domain.ReadingProgress, error)
+	ListUnfinished(ctx context.Context, userID string, limit int) ([]*domain.ReadingProgress, error)
+	DeleteByStory(ctx context.Context, storyID uint) error
+}
+
+type progressRepository struct {
+	db *gorm.DB
+}
+
+func NewProgressRepository(db *gorm.DB) ProgressRepository {
+	return &progressRepository{db: db}
+}
+
+// Save inserts the progress of the user in the story, or updates the fields given
+// to the existing one, and loads the stored progress back into progress.
+func (r *progressRepository) Save(ctx context.Context, progress *domain.ReadingProgress) error {
+	model := toModel(progress)
+	columns := []string{"last_read_at"}
+	if progress.HasPercent() {
+		columns = append(columns, "percent")
+	}
+	if progress.HasOffset() {
+		columns = append(columns, "char_offset")
+	}
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.OnConflict{DoUpdates: clause.AssignmentColumns(columns)}).
+			Create(model).Error
+		if err != nil {
+			return err
+		}
+		return tx.Where("user_id = ? AND story_id = ?", model.UserID, model.StoryID).
+			First(model).Error
+	})
+	if err != nil {
+		if isTransientError(err) {
+			return errors.NewTransientError(err)
+		}
+		return errors.NewUnexpectedError(err)
+	}
+
+	progress.Percent = model.Percent
+	progress.Offset = model.Offset
+	return nil
+}
+
+func (r *progressRepository) Get(ctx context.Context, userID, storyID string) (*domain.ReadingProgress, error) {
+	var model progressModel
+	storyIDUint, _ := strconv.ParseUint(storyID, 10, 64)
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND story_id = ?", userID, uint(storyIDUint)).
+		First(&model).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, domain.NewProgressNotFoundError(storyID)
+		}
+		if isTransientError(err) {
+			return nil, errors.NewTransientError(err)
+		}
+		return nil, errors.NewUnexpectedError(err)
+	}
+	return toDomain(&model), nil
+}
+
+// ListUnfinished returns the stories the user started but has not finished, most recently read first
+func (r *progressRepository) ListUnfinished(ctx context.Context, userID string, limit int) ([]*domain.ReadingProgress, error) {
+	var models []*progressModel
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND percent < ?", userID, domain.FinishedPercent).
+		Order("last_read_at DESC").
+		Limit(limit).
+		Find(&models).Error
+	if err != nil {
+		if isTransientError(err) {
+			return nil, errors.NewTransientError(err)
+		}
+		return nil, errors.NewUnexpectedError(err)
+	}
+
+	progress := make([]*domain.ReadingProgress, len(models))
+	for i, model := range models {
+		progress[i] = toDomain(model)
+	}
+	return progress, nil
+}
+
+// DeleteByStory removes every reader's progress in the story
+func (r *progressRepository) DeleteByStory(ctx context.Context, storyID uint) error {
+	err := r.db.WithContext(ctx).
+		Where("story_id = ?", storyID).
+		Delete(&progressModel{}).Error
+	if err != nil {
+		if isTransientError(err) {
+			return errors.NewTransientError(err)
+		}
+		return errors.NewUnexpectedError(err)
+	}
+	return nil
+}
+
+// toModel converts domain progress to database model
+func toModel(progress *domain.ReadingProgress) *progressModel {
+	return &progressModel{
+		UserID:     progress.UserID,
+		StoryID:    progress.StoryID,
+		Percent:    progress.Percent,
+		Offset:     progress.Offset,
+		LastReadAt: progress.LastReadAt,
+	}
+}
+
+// toDomain converts database model to domain progress
+func toDomain(model *progressModel) *domain.ReadingProgress {
+	return &domain.ReadingProgress{
+		UserID:     model.UserID,
+		StoryID:    model.StoryID,
+		Percent:    model.Percent,
+		Offset:     model.Offset,
+		LastReadAt: model.LastReadAt,
+	}
+}
+
+func isTransientError(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	if !stderrors.As(err, &mysqlErr) {
+		return false
+	}
+	// Common MySQL transient error codes
+	switch mysqlErr.Number {
+	case 1213, // Deadlock
+		1205, // Lock wait timeout
+		2006, // MySQL server has gone away
+		2013: // Lost connection to MySQL server
+		return true
+	}
+	return false
+}
@@ -0,0 +1,152 @@
+package service
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"time"
+
+	"go-monolith/internal/modules/progress/domain"
+	"go-monolith/internal/modules/progress/repository"
+	storydomain "go-monolith/internal/modules/story/domain"
+	"go-monolith/pkg/errors"
+	"go-monolith/pkg/events"
+	"go-monolith/pkg/logger"
+	"go-monolith/pkg/metrics"
+)
+
+const (
+	// DefaultContinueReadingLimit is the number of stories returned when no limit is given
+	DefaultContinueReadingLimit = 10
+	// MaxContinueReadingLimit caps the continue reading list
+	MaxContinueReadingLimit = 50
+)
+
+type ProgressService struct {
+	repo    repository.ProgressRepository
+	logger  logger.Logger
+	metrics *metrics.Client
+}
+
+func NewProgressService(repo repository.ProgressRepository, logger logger.Logger, metrics *metrics.Client) *ProgressService {
+	return &ProgressService{
+		repo:    repo,
+		logger:  logger,
+		metrics: metrics,
+	}
+}
+
+// Event Handlers
+
+// HandleStoryDeleted removes every reader's progress in a deleted story
+func (s *ProgressService) HandleStoryDeleted(ctx context.Context, event events.Event) {
+	deleted, ok := event.(storydomain.StoryDeleted)
+	if !ok {
+		return
+	}
+
+	if err := s.repo.DeleteByStory(ctx, deleted.StoryID); err != nil {
+		s.logger.Error(ctx, "Failed to delete reading progress of story",
+			logger.String("error", err.Error()),
+			logger.String("story_id", fmt.Sprintf("%d", deleted.StoryID)))
+		s.metrics.IncrementCounter("progress.delete.error", []string{
+			"error_type:repository",
+		})
+	}
+}
+
+// Write Operations (Commands)
+
+// SaveProgress records the latest reading position of the user in the story
+func (s *ProgressService) SaveProgress(ctx context.Context, userID, storyID string, percent *float64, offset *int64) (*domain.ReadingProgress, error) {
+	start := time.Now()
+	s.logger.Debug(ctx, "Saving reading progress", logger.String("story_id", storyID))
+
+	progress, err := domain.NewReadingProgress(userID, storyID, percent, offset)
+	if err != nil {
+		// Record validation error
+		s.metrics.IncrementCounter("progress.save.error", []string{
+			"error_type:validation",
+		})
+		return nil, err
+	}
+
+	if err := s.repo.Save(ctx, progress); err != nil {
+		var baseErr *errors.BaseError
+		if stderrors.As(err, &baseErr) && baseErr.Kind == errors.ErrKindTransient {
+			err = s.retrySave(ctx, progress)
+		}
+		if err != nil {
+			s.logger.Error(ctx, "Failed to save reading progress",
+				logger.String("error", err.Error()),
+				logger.String("story_id", storyID))
+			// Record repository error
+			s.metrics.IncrementCounter("progress.save.error", []string{
+				"error_type:repository",
+			})
+			return nil, err
+		}
+	}
+
+	s.metrics.IncrementCounter("progress.save.success", nil)
+	s.metrics.RecordTiming("progress.save.duration", time.Since(start), nil)
+	return progress, nil
+}
+
+// Read Operations (Queries)
+
+// GetProgress returns the reading position of the user in the story
+func (s *ProgressService) GetProgress(ctx context.Context, userID, storyID string) (*domain.ReadingProgress, error) {
+	progress, err := s.repo.Get(ctx, userID, storyID)
+	if err != nil {
+		if kind, ok := errors.KindOf(err); !ok || kind != errors.ErrKindNotFound {
+			s.logger.Error(ctx, "Failed to get reading progress",
+				logger.String("error", err.Error()),
+				logger.String("story_id", storyID))
+			s.metrics.IncrementCounter("progress.fetch.error", []string{
+				"error_type:repository",
+			})
+		}
+		return nil, err
+	}
+	return progress, nil
+}
+
+// ListContinueReading returns the unfinished stories of the user, most recently read first
+func (s *ProgressService) ListContinueReading(ctx context.Context, userID string, limit int) ([]*domain.ReadingProgress, error) {
+	if limit <= 0 {
+		limit = DefaultContinueReadingLimit
+	}
+	if limit > MaxContinueReadingLimit {
+		limit = MaxContinueReadingLimit
+	}
+
+	progress, err := s.repo.ListUnfinished(ctx, userID, limit)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to list continue reading",
+			logger.String("error", err.Error()))
+		s.metrics.IncrementCounter("progress.list.error", []string{
+			"error_type:repository",
+		})
+		return nil, err
+	}
+
+	s.metrics.IncrementCounter("progress.list.success", nil)
+	return progress, nil
+}
+
+// Retry Operations
+func (s *ProgressService) retrySave(ctx context.Context, progress *domain.ReadingProgress) error {
+	for i := 0; i < 3; i++ {
+		err := s.repo.Save(ctx, progress)
+		if err == nil {
+			return nil
+		}
+		var baseErr *errors.BaseError
+		if !stderrors.As(err, &baseErr) || baseErr.Kind != errors.ErrKindTransient {
+			return err
+		}
+		time.Sleep(time.Duration(i+1) * 100 * time.Millisecond)
+	}
+	return errors.NewUnexpectedError(stderrors.New("max retries exceeded"))
+}
@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"fmt"
+
+	"go-monolith/pkg/errors"
+)
+
+// ReadingListError represents reading-list-specific domain errors
+type ReadingListError struct {
+	errors.BaseError
+}
+
+func NewReadingListError(message string) error {
+	return &ReadingListError{
+		BaseError: errors.BaseError{
+			Kind:    errors.ErrKindValidation,
+			Message: message,
+		},
+	}
+}
+
+// Domain-specific error constructors
+func NewReadingListNotFoundError(id string) error {
+	return errors.NewNotFoundError("reading list", id)
+}
+
+func NewReadingListItemNotFoundError(storyID string) error {
+	return errors.NewNotFoundError("story in reading list", storyID)
+}
+
+func NewInvalidVisibilityError(visibility string) error {
+	return NewReadingListError(fmt.Sprintf("invalid visibility '%s', expected private or public", visibility))
+}
+
+func NewReservedNameError() error {
+	return NewReadingListError(fmt.Sprintf("list name '%s' is reserved", DefaultListName))
+}
+
+func NewDuplicateListNameError(name string) error {
+	return NewReadingListError(fmt.Sprintf("a list named '%s' already exists", name))
+}
+
+func NewDefaultListDeletionError() error {
+	return NewReadingListError("the default list cannot be deleted")
+}
+
+func NewListFullError() error {
+	return NewReadingListError(fmt.Sprintf("a list cannot contain more than %d stories", MaxItems))
+}
+
+func NewInvalidOrderError() error {
+	return NewReadingListError("order must contain every story in the list exactly once")
+}
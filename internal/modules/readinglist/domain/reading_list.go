@@ -0,0 +1,119 @@
+package domain
+
+import (
+	"strings"
+	"time"
+)
+
+// Visibility controls who can see a reading list
+type Visibility string
+
+const (
+	VisibilityPrivate Visibility = "private"
+	VisibilityPublic  Visibility = "public"
+)
+
+const (
+	// DefaultListName is the name of the list every user saves stories to by default
+	DefaultListName = "Saved"
+	// MaxNameLength is the longest allowed list name
+	MaxNameLength = 100
+	// MaxItems is the maximum number of stories in one list
+	MaxItems = 1000
+)
+
+// ReadingList is a user-curated, ordered collection of stories
+type ReadingList struct {
+	ID         uint
+	UserID     string
+	Name       string
+	IsDefault  bool
+	Visibility Visibility
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// ReadingListItem is a story in a reading list
+type ReadingListItem struct {
+	ListID   uint
+	StoryID  uint
+	Position int
+	AddedAt  time.Time
+}
+
+// NewReadingList validates and creates a named list
+func NewReadingList(userID, name, visibility string) (*ReadingList, error) {
+	name = strings.TrimSpace(name)
+	if userID == "" {
+		return nil, NewReadingListError("user ID cannot be empty")
+	}
+	if err := validateInputs(name, visibility); err != nil {
+		return nil, err
+	}
+	if strings.EqualFold(name, DefaultListName) {
+		return nil, NewReservedNameError()
+	}
+
+	now := time.Now()
+	return &ReadingList{
+		UserID:     userID,
+		Name:       name,
+		Visibility: Visibility(visibility),
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}, nil
+}
+
+// NewDefaultReadingList creates the private "Saved" list of a user
+func NewDefaultReadingList(userID string) *ReadingList {
+	now := time.Now()
+	return &ReadingList{
+		UserID:     userID,
+		Name:       DefaultListName,
+		IsDefault:  true,
+		Visibility: VisibilityPrivate,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+}
+
+// Update renames the list and changes its visibility. The default list keeps its name.
+func (l *ReadingList) Update(name, visibility string) error {
+	name = strings.TrimSpace(name)
+	if l.IsDefault && name != l.Name {
+		return NewReadingListError("the default list cannot be renamed")
+	}
+	if err := validateInputs(name, visibility); err != nil {
+		return err
+	}
+	if !l.IsDefault && strings.EqualFold(name, DefaultListName) {
+		return NewReservedNameError()
+	}
+
+	l.Name = name
+	l.Visibility = Visibility(visibility)
+	l.UpdatedAt = time.Now()
+	return nil
+}
+
+// IsVisibleTo reports whether the user may view the list
+func (l *ReadingList) IsVisibleTo(userID string) bool {
+	return l.Visibility == VisibilityPublic || l.UserID == userID
+}
+
+// validateInputs performs validation on raw list attributes
+func validateInputs(name, visibility string) error {
+	if name == "" {
+		return NewReadingListError("list name cannot be empty")
+	}
+	if len(name) > MaxNameLength {
+		return NewReadingListError("list name cannot exceed 100 characters")
+	}
+
+	switch Visibility(visibility) {
+	case VisibilityPrivate, VisibilityPublic:
+	default:
+		return NewInvalidVisibilityError(visibility)
+	}
+	return nil
+}
@@ -0,0 +1,28 @@
+package readinglist
+
+import (
+	"gorm.io/gorm"
+
+	"go-monolith/internal/modules/readinglist/repository"
+	"go-monolith/internal/modules/readinglist/service"
+	storydomain "go-monolith/internal/modules/story/domain"
+	"go-monolith/pkg/events"
+	"go-monolith/pkg/logger"
+	"go-monolith/pkg/metrics"
+)
+
+type Module struct {
+	ReadingListService *service.ReadingListService
+}
+
+func NewModule(db *gorm.DB, bus *events.Bus, logger logger.Logger, metrics *metrics.Client) *Module {
+	repo := repository.NewReadingListRepository(db)
+	readingListService := service.NewReadingListService(repo, logger, metrics)
+
+	// Drop reading list entries of deleted stories
+	bus.Subscribe(storydomain.EventStoryDeleted, readingListService.HandleStoryDeleted)
+
+	return &Module{
+		ReadingListService: readingListService,
+	}
+}
@@ -0,0 +1,331 @@
+package repository
+
+import (
+	"context"
+	stderrors "errors"
+	"strconv"
+	"time"
+
+	"gorm.io/gorm"
+
+	"go-monolith/internal/modules/readinglist/domain"
+	"go-monolith/pkg/errors"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+// readingListModel represents the database model
+type readingListModel struct {
+	ID         uint      `gorm:"primaryKey;autoIncrement"`
+	UserID     string    `gorm:"type:varchar(64);not null;uniqueIndex:idx_reading_lists_user_name,priority:1"`
+	Name       string    `gorm:"type:varchar(100);not null;uniqueIndex:idx_reading_lists_user_name,priority:2"`
+	IsDefault  bool      `gorm:"not null;default:false"`
+	Visibility string    `gorm:"type:varchar(16);not null;default:'private'"`
+	CreatedAt  time.Time `gorm:"not null;default:CURRENT_TIMESTAMP"`
+	UpdatedAt  time.Time `gorm:"not null;default:CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP"`
+}
+
+// TableName sets the insert table name for this struct type
+func (readingListModel) TableName() string {
+	return "reading_lists"
+}
+
+// readingListItemModel represents a story in a reading list
+type readingListItemModel struct {
+	ListID   uint      `gorm:"primaryKey"`
+	StoryID  uint      `gorm:"primaryKey;index"`
+	Position int       `gorm:"not null"`
+	AddedAt  time.Time `gorm:"not null;default:CURRENT_TIMESTAMP"`
+}
+
+// TableName sets the insert table name for this struct type
+func (readingListItemModel) TableName() string {
+	return "reading_list_items"
+}
+
+// In this context, Only benefit of using interface is to allow for mocking in tests, otherwise not needed
+type ReadingListRepository interface {
+	Create(ctx context.Context, list *domain.ReadingList) error
+	GetByID(ctx context.Context, id string) (*domain.ReadingList, error)
+	GetDefault(ctx context.Context, userID string) (*domain.ReadingList, error)
+	ListByUser(ctx context.Context, userID string) ([]*domain.ReadingList, error)
+	Update(ctx context.Context, list *domain.ReadingList) error
+	Delete(ctx context.Context, id uint) error
+
+	AddItem(ctx context.Context, listID, storyID uint) (*domain.ReadingListItem, error)
+	RemoveItem(ctx context.Context, listID, storyID uint) error
+	ListItems(ctx context.Context, listID uint) ([]*domain.ReadingListItem, error)
+	Reorder(ctx context.Context, listID uint, storyIDs []uint) error
+	IsSavedByUser(ctx context.Context, userID string, storyID uint) (bool, error)
+	RemoveStory(ctx context.Context, storyID uint) error
+}
+
+type readingListRepository struct {
+	db *gorm.DB
+}
+
+func NewReadingListRepository(db *gorm.DB) ReadingListRepository {
+	return &readingListRepository{db: db}
+}
+
+func (r *readingListRepository) Create(ctx context.Context, list *domain.ReadingList) error {
+	model := toModel(list)
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		if isDuplicateKeyError(err) {
+			return domain.NewDuplicateListNameError(list.Name)
+		}
+		return wrapError(err)
+	}
+	list.ID = model.ID
+	return nil
+}
+
+func (r *readingListRepository) GetByID(ctx context.Context, id string) (*domain.ReadingList, error) {
+	var model readingListModel
+	idUint, _ := strconv.ParseUint(id, 10, 64)
+	if err := r.db.WithContext(ctx).First(&model, uint(idUint)).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, domain.NewReadingListNotFoundError(id)
+		}
+		return nil, wrapError(err)
+	}
+	return toDomain(&model), nil
+}
+
+func (r *readingListRepository) GetDefault(ctx context.Context, userID string) (*domain.ReadingList, error) {
+	var model readingListModel
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND is_default = ?", userID, true).
+		First(&model).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, domain.NewReadingListNotFoundError(domain.DefaultListName)
+		}
+		return nil, wrapError(err)
+	}
+	return toDomain(&model), nil
+}
+
+func (r *readingListRepository) ListByUser(ctx context.Context, userID string) ([]*domain.ReadingList, error) {
+	var models []*readingListModel
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("is_default DESC, created_at ASC").
+		Find(&models).Error
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	lists := make([]*domain.ReadingList, len(models))
+	for i, model := range models {
+		lists[i] = toDomain(model)
+	}
+	return lists, nil
+}
+
+func (r *readingListRepository) Update(ctx context.Context, list *domain.ReadingList) error {
+	model := toModel(list)
+	if err := r.db.WithContext(ctx).Save(model).Error; err != nil {
+		if isDuplicateKeyError(err) {
+			return domain.NewDuplicateListNameError(list.Name)
+		}
+		return wrapError(err)
+	}
+	return nil
+}
+
+// Delete removes the list together with its items
+func (r *readingListRepository) Delete(ctx context.Context, id uint) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("list_id = ?", id).Delete(&readingListItemModel{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&readingListModel{}, id).Error
+	})
+	if err != nil {
+		return wrapError(err)
+	}
+	return nil
+}
+
+// AddItem appends the story to the end of the list. Adding a story that is
+// already in the list returns the existing item.
+func (r *readingListRepository) AddItem(ctx context.Context, listID, storyID uint) (*domain.ReadingListItem, error) {
+	var item readingListItemModel
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("list_id = ? AND story_id = ?", listID, storyID).First(&item).Error
+		if err == nil {
+			return nil
+		}
+		if err != gorm.ErrRecordNotFound {
+			return err
+		}
+
+		var stats struct {
+			Count       int64
+			MaxPosition int
+		}
+		err = tx.Model(&readingListItemModel{}).
+			Select("COUNT(*) AS count, COALESCE(MAX(position), 0) AS max_position").
+			Where("list_id = ?", listID).
+			Scan(&stats).Error
+		if err != nil {
+			return err
+		}
+		if stats.Count >= domain.MaxItems {
+			return domain.NewListFullError()
+		}
+
+		item = readingListItemModel{
+			ListID:   listID,
+			StoryID:  storyID,
+			Position: stats.MaxPosition + 1,
+			AddedAt:  time.Now(),
+		}
+		return tx.Create(&item).Error
+	})
+	if err != nil {
+		if _, ok := errors.KindOf(err); ok {
+			return nil, err
+		}
+		return nil, wrapError(err)
+	}
+	return toItemDomain(&item), nil
+}
+
+func (r *readingListRepository) RemoveItem(ctx context.Context, listID, storyID uint) error {
+	result := r.db.WithContext(ctx).
+		Where("list_id = ? AND story_id = ?", listID, storyID).
+		Delete(&readingListItemModel{})
+	if result.Error != nil {
+		return wrapError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return domain.NewReadingListItemNotFoundError(strconv.FormatUint(uint64(storyID), 10))
+	}
+	return nil
+}
+
+// RemoveStory removes the story from every list that contains it
+func (r *readingListRepository) RemoveStory(ctx context.Context, storyID uint) error {
+	err := r.db.WithContext(ctx).
+		Where("story_id = ?", storyID).
+		Delete(&readingListItemModel{}).Error
+	if err != nil {
+		return wrapError(err)
+	}
+	return nil
+}
+
+func (r *readingListRepository) ListItems(ctx context.Context, listID uint) ([]*domain.ReadingListItem, error) {
+	var models []*readingListItemModel
+	err := r.db.WithContext(ctx).
+		Where("list_id = ?", listID).
+		Order("position ASC").
+		Find(&models).Error
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	items := make([]*domain.ReadingListItem, len(models))
+	for i, model := range models {
+		items[i] = toItemDomain(model)
+	}
+	return items, nil
+}
+
+// Reorder assigns positions 1..n to the stories in the given order
+func (r *readingListRepository) Reorder(ctx context.Context, listID uint, storyIDs []uint) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for i, storyID := range storyIDs {
+			err := tx.Model(&readingListItemModel{}).
+				Where("list_id = ? AND story_id = ?", listID, storyID).
+				Update("position", i+1).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return wrapError(err)
+	}
+	return nil
+}
+
+// IsSavedByUser reports whether the story is in any of the user's lists
+func (r *readingListRepository) IsSavedByUser(ctx context.Context, userID string, storyID uint) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&readingListItemModel{}).
+		Joins("JOIN reading_lists ON reading_lists.id = reading_list_items.list_id").
+		Where("reading_lists.user_id = ? AND reading_list_items.story_id = ?", userID, storyID).
+		Count(&count).Error
+	if err != nil {
+		return false, wrapError(err)
+	}
+	return count > 0, nil
+}
+
+// toModel converts domain list to database model
+func toModel(list *domain.ReadingList) *readingListModel {
+	return &readingListModel{
+		ID:         list.ID,
+		UserID:     list.UserID,
+		Name:       list.Name,
+		IsDefault:  list.IsDefault,
+		Visibility: string(list.Visibility),
+		CreatedAt:  list.CreatedAt,
+		UpdatedAt:  list.UpdatedAt,
+	}
+}
+
+// toDomain converts database model to domain list
+func toDomain(model *readingListModel) *domain.ReadingList {
+	return &domain.ReadingList{
+		ID:         model.ID,
+		UserID:     model.UserID,
+		Name:       model.Name,
+		IsDefault:  model.IsDefault,
+		Visibility: domain.Visibility(model.Visibility),
+		CreatedAt:  model.CreatedAt,
+		UpdatedAt:  model.UpdatedAt,
+	}
+}
+
+func toItemDomain(model *readingListItemModel) *domain.ReadingListItem {
+	return &domain.ReadingListItem{
+		ListID:   model.ListID,
+		StoryID:  model.StoryID,
+		Position: model.Position,
+		AddedAt:  model.AddedAt,
+	}
+}
+
+func wrapError(err error) error {
+	if isTransientError(err) {
+		return errors.NewTransientError(err)
+	}
+	return errors.NewUnexpectedError(err)
+}
+
+func isDuplicateKeyError(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	return stderrors.As(err, &mysqlErr) && mysqlErr.Number == 1062
+}
+
+func isTransientError(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	if !stderrors.As(err, &mysqlErr) {
+		return false
+	}
+	// Common MySQL transient error codes
+	switch mysqlErr.Number {
+	case 1213, // Deadlock
+		1205, // Lock wait timeout
+		2006, // MySQL server has gone away
+		2013: // Lost connection to MySQL server
+		return true
+	}
+	return false
+}
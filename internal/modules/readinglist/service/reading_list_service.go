@@ -0,0 +1,332 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"go-monolith/internal/modules/readinglist/domain"
+	"go-monolith/internal/modules/readinglist/repository"
+	storydomain "go-monolith/internal/modules/story/domain"
+	"go-monolith/pkg/errors"
+	"go-monolith/pkg/events"
+	"go-monolith/pkg/logger"
+	"go-monolith/pkg/metrics"
+)
+
+type ReadingListService struct {
+	repo    repository.ReadingListRepository
+	logger  logger.Logger
+	metrics *metrics.Client
+}
+
+func NewReadingListService(repo repository.ReadingListRepository, logger logger.Logger, metrics *metrics.Client) *ReadingListService {
+	return &ReadingListService{
+		repo:    repo,
+		logger:  logger,
+		metrics: metrics,
+	}
+}
+
+// Event Handlers
+
+// HandleStoryDeleted removes a deleted story from every reading list
+func (s *ReadingListService) HandleStoryDeleted(ctx context.Context, event events.Event) {
+	deleted, ok := event.(storydomain.StoryDeleted)
+	if !ok {
+		return
+	}
+
+	if err := s.repo.RemoveStory(ctx, deleted.StoryID); err != nil {
+		s.logger.Error(ctx, "Failed to remove deleted story from reading lists",
+			logger.String("error", err.Error()),
+			logger.String("story_id", fmt.Sprintf("%d", deleted.StoryID)))
+		s.metrics.IncrementCounter("readinglist.item.remove.error", []string{
+			"error_type:repository",
+			"type:story_deleted",
+		})
+	}
+}
+
+// Write Operations (Commands)
+
+// CreateList creates a named list for the user
+func (s *ReadingListService) CreateList(ctx context.Context, userID, name, visibility string) (*domain.ReadingList, error) {
+	start := time.Now()
+	s.logger.Info(ctx, "Creating reading list", logger.String("name", name))
+
+	list, err := domain.NewReadingList(userID, name, visibility)
+	if err != nil {
+		s.metrics.IncrementCounter("readinglist.create.error", []string{
+			"error_type:validation",
+		})
+		return nil, err
+	}
+
+	if err := s.repo.Create(ctx, list); err != nil {
+		s.logger.Error(ctx, "Failed to save reading list",
+			logger.String("error", err.Error()),
+			logger.String("name", name))
+		s.metrics.IncrementCounter("readinglist.create.error", []string{
+			"error_type:repository",
+		})
+		return nil, err
+	}
+
+	s.metrics.IncrementCounter("readinglist.create.success", nil)
+	s.metrics.RecordTiming("readinglist.create.duration", time.Since(start), nil)
+	return list, nil
+}
+
+// UpdateList renames a list of the user or changes its visibility
+func (s *ReadingListService) UpdateList(ctx context.Context, userID, listID, name, visibility string) (*domain.ReadingList, error) {
+	list, err := s.getOwnedList(ctx, userID, listID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := list.Update(name, visibility); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Update(ctx, list); err != nil {
+		s.logger.Error(ctx, "Failed to update reading list",
+			logger.String("error", err.Error()),
+			logger.String("list_id", listID))
+		s.metrics.IncrementCounter("readinglist.update.error", []string{
+			"error_type:repository",
+		})
+		return nil, err
+	}
+	return list, nil
+}
+
+// DeleteList removes a named list of the user; the default list cannot be deleted
+func (s *ReadingListService) DeleteList(ctx context.Context, userID, listID string) error {
+	list, err := s.getOwnedList(ctx, userID, listID)
+	if err != nil {
+		return err
+	}
+	if list.IsDefault {
+		return domain.NewDefaultListDeletionError()
+	}
+
+	if err := s.repo.Delete(ctx, list.ID); err != nil {
+		s.logger.Error(ctx, "Failed to delete reading list",
+			logger.String("error", err.Error()),
+			logger.String("list_id", listID))
+		s.metrics.IncrementCounter("readinglist.delete.error", []string{
+			"error_type:repository",
+		})
+		return err
+	}
+	return nil
+}
+
+// AddStory appends a story to a list of the user
+func (s *ReadingListService) AddStory(ctx context.Context, userID, listID, storyID string) (*domain.ReadingListItem, error) {
+	list, err := s.getOwnedList(ctx, userID, listID)
+	if err != nil {
+		return nil, err
+	}
+	return s.addItem(ctx, list, storyID)
+}
+
+// RemoveStory removes a story from a list of the user
+func (s *ReadingListService) RemoveStory(ctx context.Context, userID, listID, storyID string) error {
+	list, err := s.getOwnedList(ctx, userID, listID)
+	if err != nil {
+		return err
+	}
+	return s.removeItem(ctx, list, storyID)
+}
+
+// SaveStory adds a story to the user's default list, creating the list if needed
+func (s *ReadingListService) SaveStory(ctx context.Context, userID, storyID string) (*domain.ReadingListItem, error) {
+	list, err := s.GetDefaultList(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return s.addItem(ctx, list, storyID)
+}
+
+// UnsaveStory removes a story from the user's default list
+func (s *ReadingListService) UnsaveStory(ctx context.Context, userID, storyID string) error {
+	list, err := s.GetDefaultList(ctx, userID)
+	if err != nil {
+		return err
+	}
+	return s.removeItem(ctx, list, storyID)
+}
+
+// Reorder sets the order of the stories in a list of the user.
+// storyIDs must contain every story of the list exactly once.
+func (s *ReadingListService) Reorder(ctx context.Context, userID, listID string, storyIDs []string) error {
+	list, err := s.getOwnedList(ctx, userID, listID)
+	if err != nil {
+		return err
+	}
+
+	items, err := s.repo.ListItems(ctx, list.ID)
+	if err != nil {
+		return err
+	}
+	if len(items) != len(storyIDs) {
+		return domain.NewInvalidOrderError()
+	}
+
+	current := make(map[uint]bool, len(items))
+	for _, item := range items {
+		current[item.StoryID] = true
+	}
+	order := make([]uint, len(storyIDs))
+	for i, id := range storyIDs {
+		storyID, err := parseStoryID(id)
+		if err != nil {
+			return err
+		}
+		if !current[storyID] {
+			return domain.NewInvalidOrderError()
+		}
+		// Clear each story as it is seen so duplicates are rejected
+		current[storyID] = false
+		order[i] = storyID
+	}
+
+	if err := s.repo.Reorder(ctx, list.ID, order); err != nil {
+		s.logger.Error(ctx, "Failed to reorder reading list",
+			logger.String("error", err.Error()),
+			logger.String("list_id", listID))
+		s.metrics.IncrementCounter("readinglist.reorder.error", []string{
+			"error_type:repository",
+		})
+		return err
+	}
+	return nil
+}
+
+// Read Operations (Queries)
+
+// GetDefaultList returns the user's "Saved" list, creating it on first use
+func (s *ReadingListService) GetDefaultList(ctx context.Context, userID string) (*domain.ReadingList, error) {
+	list, err := s.repo.GetDefault(ctx, userID)
+	if err == nil {
+		return list, nil
+	}
+	if !isNotFound(err) {
+		return nil, err
+	}
+
+	list = domain.NewDefaultReadingList(userID)
+	if err := s.repo.Create(ctx, list); err != nil {
+		// Another request may have created it concurrently
+		if existing, getErr := s.repo.GetDefault(ctx, userID); getErr == nil {
+			return existing, nil
+		}
+		s.logger.Error(ctx, "Failed to create default reading list",
+			logger.String("error", err.Error()))
+		return nil, err
+	}
+	return list, nil
+}
+
+// ListUserLists returns all lists of the user, default list first
+func (s *ReadingListService) ListUserLists(ctx context.Context, userID string) ([]*domain.ReadingList, error) {
+	if _, err := s.GetDefaultList(ctx, userID); err != nil {
+		return nil, err
+	}
+	return s.repo.ListByUser(ctx, userID)
+}
+
+// GetList returns a list and its items if it is visible to the viewer.
+// Private lists of other users are reported as not found.
+func (s *ReadingListService) GetList(ctx context.Context, viewerID, listID string) (*domain.ReadingList, []*domain.ReadingListItem, error) {
+	list, err := s.repo.GetByID(ctx, listID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !list.IsVisibleTo(viewerID) {
+		return nil, nil, domain.NewReadingListNotFoundError(listID)
+	}
+
+	items, err := s.repo.ListItems(ctx, list.ID)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to list reading list items",
+			logger.String("error", err.Error()),
+			logger.String("list_id", listID))
+		return nil, nil, err
+	}
+	return list, items, nil
+}
+
+// IsSaved reports whether the story is in any of the user's lists
+func (s *ReadingListService) IsSaved(ctx context.Context, userID, storyID string) (bool, error) {
+	id, err := parseStoryID(storyID)
+	if err != nil {
+		return false, err
+	}
+	return s.repo.IsSavedByUser(ctx, userID, id)
+}
+
+// getOwnedList loads a list and checks that it belongs to the user
+func (s *ReadingListService) getOwnedList(ctx context.Context, userID, listID string) (*domain.ReadingList, error) {
+	list, err := s.repo.GetByID(ctx, listID)
+	if err != nil {
+		return nil, err
+	}
+	if list.UserID != userID {
+		return nil, domain.NewReadingListNotFoundError(listID)
+	}
+	return list, nil
+}
+
+func (s *ReadingListService) addItem(ctx context.Context, list *domain.ReadingList, storyID string) (*domain.ReadingListItem, error) {
+	id, err := parseStoryID(storyID)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := s.repo.AddItem(ctx, list.ID, id)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to add story to reading list",
+			logger.String("error", err.Error()),
+			logger.String("list_id", fmt.Sprintf("%d", list.ID)),
+			logger.String("story_id", storyID))
+		s.metrics.IncrementCounter("readinglist.add.error", nil)
+		return nil, err
+	}
+
+	s.metrics.IncrementCounter("readinglist.add.success", []string{
+		"default:" + strconv.FormatBool(list.IsDefault),
+	})
+	return item, nil
+}
+
+func (s *ReadingListService) removeItem(ctx context.Context, list *domain.ReadingList, storyID string) error {
+	id, err := parseStoryID(storyID)
+	if err != nil {
+		return err
+	}
+	if err := s.repo.RemoveItem(ctx, list.ID, id); err != nil {
+		return err
+	}
+
+	s.metrics.IncrementCounter("readinglist.remove.success", []string{
+		"default:" + strconv.FormatBool(list.IsDefault),
+	})
+	return nil
+}
+
+func parseStoryID(id string) (uint, error) {
+	parsed, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return 0, domain.NewReadingListError("invalid story ID format")
+	}
+	return uint(parsed), nil
+}
+
+func isNotFound(err error) bool {
+	kind, ok := errors.KindOf(err)
+	return ok && kind == errors.ErrKindNotFound
+}
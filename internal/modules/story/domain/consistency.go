@@ -0,0 +1,15 @@
+package domain
+
+// OrphanReport lists the stories whose author no longer exists
+type OrphanReport struct {
+	// CheckedAuthors is the number of distinct authors referenced by stories
+	CheckedAuthors int
+	// MissingAuthorIDs are the referenced authors that do not exist
+	MissingAuthorIDs []uint
+	Stories          []*Story
+}
+
+// HasOrphans reports whether any story references a missing author
+func (r *OrphanReport) HasOrphans() bool {
+	return len(r.Stories) > 0
+}
@@ -1,6 +1,8 @@
 package domain
 
 import (
+	"fmt"
+
 	"go-monolith/pkg/errors"
 )
 
@@ -49,6 +51,42 @@ func NewInvalidAuthorError() error {
 	return NewStoryError("author is required", nil)
 }
 
+func NewUnknownAuthorError(authorID uint) error {
+	return NewStoryError(fmt.Sprintf("author %d does not exist", authorID), nil)
+}
+
 func NewInvalidStatusError() error {
 	return NewStoryError("invalid story status", nil)
 }
+
+// DuplicateStoryError is returned when a story matches another story of its author
+type DuplicateStoryError struct {
+	StoryError
+	ExistingStoryID uint
+	Similarity      float64
+}
+
+// ProblemExtensions adds the matching story to the problem response describing the error
+func (e *DuplicateStoryError) ProblemExtensions() map[string]interface{} {
+	return map[string]interface{}{
+		"existingStoryId": e.ExistingStoryID,
+		"similarity":      e.Similarity,
+	}
+}
+
+func NewDuplicateStoryError(existingStoryID uint, similarity float64) error {
+	message := fmt.Sprintf("story duplicates existing story %d of the author", existingStoryID)
+	if similarity < 1 {
+		message = fmt.Sprintf("story is %.0f%% similar to existing story %d of the author", similarity*100, existingStoryID)
+	}
+	return &DuplicateStoryError{
+		StoryError: StoryError{
+			BaseError: errors.BaseError{
+				Kind:    errors.ErrKindValidation,
+				Message: message,
+			},
+		},
+		ExistingStoryID: existingStoryID,
+		Similarity:      similarity,
+	}
+}
@@ -0,0 +1,91 @@
+package domain
+
+import "time"
+
+// Event names published by the story module
+const (
+	EventStoryCreated   = "story.created"
+	EventStoryUpdated   = "story.updated"
+	EventStoryPublished = "story.published"
+	EventStoryDeleted   = "story.deleted"
+	EventStoryEngaged   = "story.engaged"
+	EventViewsAdded     = "story.views_added"
+)
+
+// EngagementKind describes how a reader interacted with a story
+type EngagementKind string
+
+const (
+	EngagementView    EngagementKind = "view"
+	EngagementLike    EngagementKind = "like"
+	EngagementComment EngagementKind = "comment"
+)
+
+// StoryCreated is published after a story has been stored
+type StoryCreated struct {
+	StoryID   uint
+	AuthorID  uint
+	CreatedAt time.Time
+}
+
+func (StoryCreated) EventName() string { return EventStoryCreated }
+
+// StoryUpdated is published after a story's title, content or author has changed
+type StoryUpdated struct {
+	StoryID   uint
+	AuthorID  uint
+	UpdatedAt time.Time
+}
+
+func (StoryUpdated) EventName() string { return EventStoryUpdated }
+
+// StoryPublished is published once, when a story is first made public
+type StoryPublished struct {
+	StoryID     uint
+	AuthorID    uint
+	Title       string
+	PublishedAt time.Time
+}
+
+func (StoryPublished) EventName() string { return EventStoryPublished }
+
+// StoryDeleted is published after a story has been removed, with the
+// publication state and engagement counters the story had
+type StoryDeleted struct {
+	StoryID     uint
+	AuthorID    uint
+	PublishedAt *time.Time
+	Views       int64
+	Likes       int64
+	DeletedAt   time.Time
+}
+
+func (StoryDeleted) EventName() string { return EventStoryDeleted }
+
+// StoryEngaged is published whenever a reader likes or comments on a story. Views
+// are recorded by the analytics module instead, to keep reads cheap.
+type StoryEngaged struct {
+	StoryID    uint
+	AuthorID   uint
+	Title      string
+	UserID     string
+	Kind       EngagementKind
+	OccurredAt time.Time
+}
+
+func (StoryEngaged) EventName() string { return EventStoryEngaged }
+
+// AuthorViews is the number of views added to the stories of one author
+type AuthorViews struct {
+	AuthorID uint
+	Views    int64
+}
+
+// ViewsAdded is published after compacted views were added to the story counters,
+// with the views per author of the live stories that received them
+type ViewsAdded struct {
+	Views   []AuthorViews
+	AddedAt time.Time
+}
+
+func (ViewsAdded) EventName() string { return EventViewsAdded }
@@ -0,0 +1,31 @@
+package domain
+
+import (
+	"time"
+)
+
+const (
+	// TrendingWindow is how far back published stories are considered for trending
+	TrendingWindow = 7 * 24 * time.Hour
+
+	// Weights of engagement signals in the trending score
+	TrendingViewWeight    = 1
+	TrendingLikeWeight    = 5
+	TrendingCommentWeight = 10
+)
+
+// FeedCursor marks the last story of a feed page. Stories are ordered by
+// publication time and then ID, both descending, so the next page starts
+// strictly after the cursor.
+type FeedCursor struct {
+	PublishedAt time.Time
+	ID          uint
+}
+
+// CursorOf returns the cursor pointing at the given published story
+func CursorOf(story *Story) *FeedCursor {
+	if story.PublishedAt == nil {
+		return nil
+	}
+	return &FeedCursor{PublishedAt: *story.PublishedAt, ID: story.ID}
+}
@@ -0,0 +1,147 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"hash/fnv"
+	"math"
+	"strings"
+	"unicode"
+)
+
+const (
+	// DuplicateThreshold is the estimated Jaccard similarity above which a story
+	// is considered a near-duplicate of another one
+	DuplicateThreshold = 0.85
+
+	// shingleSize is the number of consecutive words in a shingle
+	shingleSize = 5
+	// signatureSize is the number of MinHash values kept per story
+	signatureSize = 64
+	// bandCount is the number of LSH bands the signature is split into.
+	// With 16 bands of 4 rows, pairs above ~0.5 similarity almost always share a band.
+	bandCount   = 16
+	rowsPerBand = signatureSize / bandCount
+)
+
+// Fingerprint identifies the content of a story for duplicate detection
+type Fingerprint struct {
+	// ContentHash is the SHA-256 of the normalized content and catches exact copies
+	ContentHash string
+	// Signature is the MinHash signature of the content shingles and estimates similarity
+	Signature []uint64
+}
+
+// NewFingerprint computes the fingerprint of story content
+func NewFingerprint(content string) Fingerprint {
+	words := normalizeWords(content)
+
+	sum := sha256.Sum256([]byte(strings.Join(words, " ")))
+	return Fingerprint{
+		ContentHash: hex.EncodeToString(sum[:]),
+		Signature:   minHash(shingles(words)),
+	}
+}
+
+// Similarity estimates the Jaccard similarity of the shingle sets behind two fingerprints
+func (f Fingerprint) Similarity(other Fingerprint) float64 {
+	if f.ContentHash == other.ContentHash {
+		return 1
+	}
+	if len(f.Signature) != len(other.Signature) || len(f.Signature) == 0 {
+		return 0
+	}
+
+	matches := 0
+	for i := range f.Signature {
+		if f.Signature[i] == other.Signature[i] {
+			matches++
+		}
+	}
+	return float64(matches) / float64(len(f.Signature))
+}
+
+// Bands returns the locality-sensitive hash of each signature band.
+// Two similar fingerprints are very likely to share at least one band hash,
+// which lets candidates be looked up without comparing against every story.
+func (f Fingerprint) Bands() []uint64 {
+	if len(f.Signature) != signatureSize {
+		return nil
+	}
+
+	bands := make([]uint64, bandCount)
+	buf := make([]byte, 8)
+	for b := 0; b < bandCount; b++ {
+		h := fnv.New64a()
+		for _, v := range f.Signature[b*rowsPerBand : (b+1)*rowsPerBand] {
+			for i := 0; i < 8; i++ {
+				buf[i] = byte(v >> (8 * i))
+			}
+			h.Write(buf)
+		}
+		bands[b] = h.Sum64()
+	}
+	return bands
+}
+
+// normalizeWords lowercases the content and splits it into words, dropping punctuation
+func normalizeWords(content string) []string {
+	return strings.FieldsFunc(strings.ToLower(content), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+}
+
+// shingles returns the set of word n-grams of the content
+func shingles(words []string) map[uint64]struct{} {
+	set := make(map[uint64]struct{})
+	if len(words) == 0 {
+		return set
+	}
+
+	n := shingleSize
+	if len(words) < n {
+		n = len(words)
+	}
+	for i := 0; i+n <= len(words); i++ {
+		h := fnv.New64a()
+		h.Write([]byte(strings.Join(words[i:i+n], " ")))
+		set[h.Sum64()] = struct{}{}
+	}
+	return set
+}
+
+// minHash computes the MinHash signature of a shingle set using
+// signatureSize hash functions of the form (a*x + b) derived from fixed seeds
+func minHash(set map[uint64]struct{}) []uint64 {
+	signature := make([]uint64, signatureSize)
+	for i := range signature {
+		signature[i] = math.MaxUint64
+	}
+
+	for shingle := range set {
+		for i := 0; i < signatureSize; i++ {
+			a, b := hashSeed(i)
+			if v := mix(a*shingle + b); v < signature[i] {
+				signature[i] = v
+			}
+		}
+	}
+	return signature
+}
+
+// hashSeed returns the deterministic coefficients of the i-th hash function
+func hashSeed(i int) (uint64, uint64) {
+	a := mix(uint64(i)*2+1) | 1
+	b := mix(uint64(i)*2 + 2)
+	return a, b
+}
+
+// mix is the splitmix64 finalizer, used to spread hash bits evenly
+func mix(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
@@ -18,6 +18,11 @@ type Story struct {
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
 	PublishedAt *time.Time
+
+	// Engagement counters are maintained by the repository and are read-only here
+	Views    int64
+	Likes    int64
+	Comments int64
 }
 
 var validate = validator.New()
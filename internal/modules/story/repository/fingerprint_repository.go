@@ -0,0 +1,193 @@
+package repository
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"go-monolith/internal/modules/story/domain"
+	"go-monolith/pkg/errors"
+)
+
+// fingerprintModel stores the content fingerprint of a story
+type fingerprintModel struct {
+	StoryID     uint   `gorm:"primaryKey"`
+	ContentHash string `gorm:"type:char(64);not null;index"`
+	Signature   string `gorm:"type:text;not null"`
+}
+
+// TableName sets the insert table name for this struct type
+func (fingerprintModel) TableName() string {
+	return "story_fingerprints"
+}
+
+// fingerprintBandModel indexes a fingerprint by its LSH band hashes
+type fingerprintBandModel struct {
+	StoryID uint   `gorm:"primaryKey"`
+	Band    int    `gorm:"primaryKey;index:idx_story_fingerprint_bands_lookup,priority:1"`
+	Hash    uint64 `gorm:"not null;index:idx_story_fingerprint_bands_lookup,priority:2"`
+}
+
+// TableName sets the insert table name for this struct type
+func (fingerprintBandModel) TableName() string {
+	return "story_fingerprint_bands"
+}
+
+// FingerprintRepository persists story fingerprints and finds possible duplicates
+type FingerprintRepository interface {
+	Save(ctx context.Context, storyID uint, fingerprint domain.Fingerprint) error
+	FindByContentHash(ctx context.Context, authorID uint, hash string) ([]uint, error)
+	FindCandidates(ctx context.Context, authorID uint, fingerprint domain.Fingerprint) (map[uint]domain.Fingerprint, error)
+	Delete(ctx context.Context, storyID uint) error
+}
+
+type fingerprintRepository struct {
+	db *gorm.DB
+}
+
+func NewFingerprintRepository(db *gorm.DB) FingerprintRepository {
+	return &fingerprintRepository{db: db}
+}
+
+func (r *fingerprintRepository) Save(ctx context.Context, storyID uint, fingerprint domain.Fingerprint) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		model := &fingerprintModel{
+			StoryID:     storyID,
+			ContentHash: fingerprint.ContentHash,
+			Signature:   encodeSignature(fingerprint.Signature),
+		}
+		if err := tx.Clauses(clause.OnConflict{UpdateAll: true}).Create(model).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Where("story_id = ?", storyID).Delete(&fingerprintBandModel{}).Error; err != nil {
+			return err
+		}
+		bands := fingerprint.Bands()
+		if len(bands) == 0 {
+			return nil
+		}
+		rows := make([]fingerprintBandModel, len(bands))
+		for i, hash := range bands {
+			rows[i] = fingerprintBandModel{StoryID: storyID, Band: i, Hash: hash}
+		}
+		return tx.Create(&rows).Error
+	})
+	if err != nil {
+		if isTransientError(err) {
+			return errors.NewTransientError(err)
+		}
+		return errors.NewUnexpectedError(err)
+	}
+	return nil
+}
+
+// FindByContentHash returns the live stories of the author whose content has the hash
+func (r *fingerprintRepository) FindByContentHash(ctx context.Context, authorID uint, hash string) ([]uint, error) {
+	var storyIDs []uint
+	err := r.db.WithContext(ctx).
+		Model(&fingerprintModel{}).
+		Joins(joinAuthorStories("story_fingerprints"), authorID).
+		Where("story_fingerprints.content_hash = ?", hash).
+		Order("story_fingerprints.story_id ASC").
+		Pluck("story_fingerprints.story_id", &storyIDs).Error
+	if err != nil {
+		if isTransientError(err) {
+			return nil, errors.NewTransientError(err)
+		}
+		return nil, errors.NewUnexpectedError(err)
+	}
+	return storyIDs, nil
+}
+
+// FindCandidates returns the fingerprints of the author's live stories sharing at
+// least one LSH band with fingerprint
+func (r *fingerprintRepository) FindCandidates(ctx context.Context, authorID uint, fingerprint domain.Fingerprint) (map[uint]domain.Fingerprint, error) {
+	bands := fingerprint.Bands()
+	if len(bands) == 0 {
+		return map[uint]domain.Fingerprint{}, nil
+	}
+
+	query := r.db.WithContext(ctx).
+		Model(&fingerprintBandModel{}).
+		Joins(joinAuthorStories("story_fingerprint_bands"), authorID).
+		Distinct("story_fingerprint_bands.story_id")
+	conditions := r.db.Where("band = ? AND hash = ?", 0, bands[0])
+	for i := 1; i < len(bands); i++ {
+		conditions = conditions.Or("band = ? AND hash = ?", i, bands[i])
+	}
+
+	var storyIDs []uint
+	if err := query.Where(conditions).Pluck("story_fingerprint_bands.story_id", &storyIDs).Error; err != nil {
+		if isTransientError(err) {
+			return nil, errors.NewTransientError(err)
+		}
+		return nil, errors.NewUnexpectedError(err)
+	}
+	if len(storyIDs) == 0 {
+		return map[uint]domain.Fingerprint{}, nil
+	}
+
+	var models []*fingerprintModel
+	if err := r.db.WithContext(ctx).Where("story_id IN ?", storyIDs).Find(&models).Error; err != nil {
+		if isTransientError(err) {
+			return nil, errors.NewTransientError(err)
+		}
+		return nil, errors.NewUnexpectedError(err)
+	}
+
+	candidates := make(map[uint]domain.Fingerprint, len(models))
+	for _, model := range models {
+		candidates[model.StoryID] = domain.Fingerprint{
+			ContentHash: model.ContentHash,
+			Signature:   decodeSignature(model.Signature),
+		}
+	}
+	return candidates, nil
+}
+
+func (r *fingerprintRepository) Delete(ctx context.Context, storyID uint) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("story_id = ?", storyID).Delete(&fingerprintBandModel{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("story_id = ?", storyID).Delete(&fingerprintModel{}).Error
+	})
+	if err != nil {
+		if isTransientError(err) {
+			return errors.NewTransientError(err)
+		}
+		return errors.NewUnexpectedError(err)
+	}
+	return nil
+}
+
+// encodeSignature stores a MinHash signature as comma-separated hex values
+// joinAuthorStories joins the live stories of an author, given as the join's
+// argument, to a table keyed by story_id
+func joinAuthorStories(table string) string {
+	return "JOIN stories ON stories.id = " + table + ".story_id AND stories.author_id = ? AND stories.deleted_at IS NULL"
+}
+
+func encodeSignature(signature []uint64) string {
+	parts := make([]string, len(signature))
+	for i, v := range signature {
+		parts[i] = strconv.FormatUint(v, 16)
+	}
+	return strings.Join(parts, ",")
+}
+
+func decodeSignature(encoded string) []uint64 {
+	if encoded == "" {
+		return nil
+	}
+	parts := strings.Split(encoded, ",")
+	signature := make([]uint64, len(parts))
+	for i, part := range parts {
+		signature[i], _ = strconv.ParseUint(part, 16, 64)
+	}
+	return signature
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"go-monolith/internal/modules/story/domain"
 	"go-monolith/pkg/errors"
@@ -26,6 +27,8 @@ type storyModel struct {
 	Views       int64 `gorm:"not null;default:0"`
 	Likes       int64 `gorm:"not null;default:0"`
 	Comments    int64 `gorm:"not null;default:0"`
+	// DeletedAt is set when the story was removed together with its author
+	DeletedAt gorm.DeletedAt `gorm:"index"`
 }
 
 // TableName sets the insert table name for this struct type
@@ -33,6 +36,25 @@ func (storyModel) TableName() string {
 	return "stories" // Use the correct table name
 }
 
+// storyLikeModel records that a user liked a story, at most once per user
+type storyLikeModel struct {
+	StoryID   uint      `gorm:"primaryKey"`
+	UserID    string    `gorm:"primaryKey;type:varchar(64)"`
+	CreatedAt time.Time `gorm:"not null;default:CURRENT_TIMESTAMP"`
+}
+
+// TableName sets the insert table name for this struct type
+func (storyLikeModel) TableName() string {
+	return "story_likes"
+}
+
+// engagementColumns maps engagement kinds to the counter column they increment.
+// Views are added in bulk with AddViews.
+var engagementColumns = map[domain.EngagementKind]string{
+	domain.EngagementLike:    "likes",
+	domain.EngagementComment: "comments",
+}
+
 // StoryRepository interface defines the contract for story repository operations
 // In this context, only benefit of using interface is to allow for mocking in tests, otherwise not needed
 type StoryRepository interface {
@@ -40,8 +62,19 @@ type StoryRepository interface {
 	Update(ctx context.Context, story *domain.Story) error
 	Delete(ctx context.Context, id string) error
 	GetByID(ctx context.Context, id string) (*domain.Story, error)
+	GetByIDs(ctx context.Context, ids []uint) ([]*domain.Story, error)
 	List(ctx context.Context, limit, offset int) ([]*domain.Story, error)
-	ListByAuthor(ctx context.Context, authorID string, limit, offset int) ([]*domain.Story, error)
+	ListByAuthor(ctx context.Context, authorID string, includeDrafts bool, limit, offset int) ([]*domain.Story, error)
+	IncrementEngagement(ctx context.Context, id string, kind domain.EngagementKind) error
+	AddViews(ctx context.Context, views map[uint]int64) ([]domain.AuthorViews, error)
+	AddLike(ctx context.Context, id string, userID string) (bool, error)
+	ListPublishedByAuthors(ctx context.Context, authorIDs []uint, after *domain.FeedCursor, limit int) ([]*domain.Story, error)
+	ListTrending(ctx context.Context, since time.Time, limit, offset int) ([]*domain.Story, error)
+	ListAuthorIDs(ctx context.Context) ([]uint, error)
+	ListByAuthorIDs(ctx context.Context, authorIDs []uint) ([]*domain.Story, error)
+	CountByAuthor(ctx context.Context, authorID uint) (int64, error)
+	DeleteByAuthor(ctx context.Context, authorID uint) ([]*domain.Story, error)
+	ReassignAuthor(ctx context.Context, fromID, toID uint) ([]*domain.Story, error)
 }
 
 type storyRepository struct {
@@ -71,7 +104,8 @@ func (r *storyRepository) Create(ctx context.Context, story *domain.Story) error
 
 func (r *storyRepository) Update(ctx context.Context, story *domain.Story) error {
 	model := toModel(story)
-	if err := r.db.WithContext(ctx).Save(model).Error; err != nil {
+	// Engagement counters are only changed through IncrementEngagement, AddLike and AddViews
+	if err := r.db.WithContext(ctx).Omit("views", "likes", "comments").Save(model).Error; err != nil {
 		if isDuplicateKeyError(err) {
 			return errors.NewValidationError("story already exists")
 		}
@@ -83,9 +117,16 @@ func (r *storyRepository) Update(ctx context.Context, story *domain.Story) error
 	return nil
 }
 
+// Delete removes the story together with its likes
 func (r *storyRepository) Delete(ctx context.Context, id string) error {
 	idUint, _ := strconv.ParseUint(id, 10, 64)
-	if err := r.db.WithContext(ctx).Delete(&storyModel{}, uint(idUint)).Error; err != nil {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("story_id = ?", uint(idUint)).Delete(&storyLikeModel{}).Error; err != nil {
+			return err
+		}
+		return tx.Unscoped().Delete(&storyModel{}, uint(idUint)).Error
+	})
+	if err != nil {
 		if isTransientError(err) {
 			return errors.NewTransientError(err)
 		}
@@ -109,9 +150,32 @@ func (r *storyRepository) GetByID(ctx context.Context, id string) (*domain.Story
 	return toDomain(&model), nil
 }
 
+// GetByIDs returns the existing stories among ids, in no particular order
+func (r *storyRepository) GetByIDs(ctx context.Context, ids []uint) ([]*domain.Story, error) {
+	if len(ids) == 0 {
+		return []*domain.Story{}, nil
+	}
+
+	var models []*storyModel
+	if err := r.db.WithContext(ctx).Where("id IN ?", ids).Find(&models).Error; err != nil {
+		if isTransientError(err) {
+			return nil, errors.NewTransientError(err)
+		}
+		return nil, errors.NewUnexpectedError(err)
+	}
+
+	stories := make([]*domain.Story, len(models))
+	for i, model := range models {
+		stories[i] = toDomain(model)
+	}
+	return stories, nil
+}
+
+// List returns published stories, newest first
 func (r *storyRepository) List(ctx context.Context, limit, offset int) ([]*domain.Story, error) {
 	var models []*storyModel
 	err := r.db.WithContext(ctx).
+		Where("published_at IS NOT NULL").
 		Order("created_at DESC").
 		Limit(limit).
 		Offset(offset).
@@ -130,11 +194,17 @@ func (r *storyRepository) List(ctx context.Context, limit, offset int) ([]*domai
 	return stories, nil
 }
 
-func (r *storyRepository) ListByAuthor(ctx context.Context, authorID string, limit, offset int) ([]*domain.Story, error) {
+// ListByAuthor returns the stories of an author, newest first. Drafts are left out
+// unless includeDrafts is set.
+func (r *storyRepository) ListByAuthor(ctx context.Context, authorID string, includeDrafts bool, limit, offset int) ([]*domain.Story, error) {
 	var models []*storyModel
 	authorIDUint, _ := strconv.ParseUint(authorID, 10, 64)
-	err := r.db.WithContext(ctx).
-		Where("author_id = ?", uint(authorIDUint)).
+	query := r.db.WithContext(ctx).
+		Where("author_id = ?", uint(authorIDUint))
+	if !includeDrafts {
+		query = query.Where("published_at IS NOT NULL")
+	}
+	err := query.
 		Order("created_at DESC").
 		Limit(limit).
 		Offset(offset).
@@ -153,6 +223,286 @@ func (r *storyRepository) ListByAuthor(ctx context.Context, authorID string, lim
 	return stories, nil
 }
 
+func (r *storyRepository) IncrementEngagement(ctx context.Context, id string, kind domain.EngagementKind) error {
+	column, ok := engagementColumns[kind]
+	if !ok {
+		return errors.NewValidationError("invalid engagement kind: " + string(kind))
+	}
+	idUint, _ := strconv.ParseUint(id, 10, 64)
+	err := r.db.WithContext(ctx).
+		Model(&storyModel{}).
+		Where("id = ?", uint(idUint)).
+		UpdateColumn(column, gorm.Expr(column+" + ?", 1)).Error
+	if err != nil {
+		if isTransientError(err) {
+			return errors.NewTransientError(err)
+		}
+		return errors.NewUnexpectedError(err)
+	}
+	return nil
+}
+
+// AddViews adds counted views to the live stories keyed by ID in one transaction,
+// skipping deleted ones, and returns the views added per author. Stories are locked
+// in ID order so concurrent calls cannot deadlock.
+func (r *storyRepository) AddViews(ctx context.Context, views map[uint]int64) ([]domain.AuthorViews, error) {
+	ids := make([]uint, 0, len(views))
+	for id := range views {
+		ids = append(ids, id)
+	}
+
+	var added []domain.AuthorViews
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var models []*storyModel
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Select("id", "author_id").
+			Where("id IN ?", ids).
+			Order("id").
+			Find(&models).Error
+		if err != nil {
+			return err
+		}
+
+		byAuthor := make(map[uint]int64)
+		for _, model := range models {
+			err := tx.Model(&storyModel{}).
+				Where("id = ?", model.ID).
+				UpdateColumn("views", gorm.Expr("views + ?", views[model.ID])).Error
+			if err != nil {
+				return err
+			}
+			if _, ok := byAuthor[model.AuthorID]; !ok {
+				added = append(added, domain.AuthorViews{AuthorID: model.AuthorID})
+			}
+			byAuthor[model.AuthorID] += views[model.ID]
+		}
+		for i := range added {
+			added[i].Views = byAuthor[added[i].AuthorID]
+		}
+		return nil
+	})
+	if err != nil {
+		if isTransientError(err) {
+			return nil, errors.NewTransientError(err)
+		}
+		return nil, errors.NewUnexpectedError(err)
+	}
+	return added, nil
+}
+
+// AddLike records a like by the user and reports whether it is new. A new like and
+// the story's like counter are written in one transaction, so neither is kept without the other.
+func (r *storyRepository) AddLike(ctx context.Context, id string, userID string) (bool, error) {
+	idUint, _ := strconv.ParseUint(id, 10, 64)
+	like := &storyLikeModel{StoryID: uint(idUint), UserID: userID, CreatedAt: time.Now()}
+	added := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(like).Error; err != nil {
+			if isDuplicateKeyError(err) {
+				return nil
+			}
+			return err
+		}
+		err := tx.Model(&storyModel{}).
+			Where("id = ?", like.StoryID).
+			UpdateColumn("likes", gorm.Expr("likes + ?", 1)).Error
+		if err != nil {
+			return err
+		}
+		added = true
+		return nil
+	})
+	if err != nil {
+		if isTransientError(err) {
+			return false, errors.NewTransientError(err)
+		}
+		return false, errors.NewUnexpectedError(err)
+	}
+	return added, nil
+}
+
+// ListPublishedByAuthors returns published stories of the given authors, newest first,
+// starting strictly after the cursor when one is given
+func (r *storyRepository) ListPublishedByAuthors(ctx context.Context, authorIDs []uint, after *domain.FeedCursor, limit int) ([]*domain.Story, error) {
+	if len(authorIDs) == 0 {
+		return []*domain.Story{}, nil
+	}
+
+	var models []*storyModel
+	query := r.db.WithContext(ctx).
+		Where("author_id IN ? AND published_at IS NOT NULL", authorIDs)
+	if after != nil {
+		query = query.Where("(published_at < ?) OR (published_at = ? AND id < ?)",
+			after.PublishedAt, after.PublishedAt, after.ID)
+	}
+	err := query.
+		Order("published_at DESC, id DESC").
+		Limit(limit).
+		Find(&models).Error
+	if err != nil {
+		if isTransientError(err) {
+			return nil, errors.NewTransientError(err)
+		}
+		return nil, errors.NewUnexpectedError(err)
+	}
+
+	stories := make([]*domain.Story, len(models))
+	for i, model := range models {
+		stories[i] = toDomain(model)
+	}
+	return stories, nil
+}
+
+// ListTrending returns stories published since the given time, ordered by weighted engagement
+func (r *storyRepository) ListTrending(ctx context.Context, since time.Time, limit, offset int) ([]*domain.Story, error) {
+	var models []*storyModel
+	err := r.db.WithContext(ctx).
+		Where("published_at IS NOT NULL AND published_at >= ?", since).
+		Order(gorm.Expr("(views * ? + likes * ? + comments * ?) DESC, id DESC",
+			domain.TrendingViewWeight, domain.TrendingLikeWeight, domain.TrendingCommentWeight)).
+		Limit(limit).
+		Offset(offset).
+		Find(&models).Error
+	if err != nil {
+		if isTransientError(err) {
+			return nil, errors.NewTransientError(err)
+		}
+		return nil, errors.NewUnexpectedError(err)
+	}
+
+	stories := make([]*domain.Story, len(models))
+	for i, model := range models {
+		stories[i] = toDomain(model)
+	}
+	return stories, nil
+}
+
+// ListAuthorIDs returns the distinct authors referenced by stories
+func (r *storyRepository) ListAuthorIDs(ctx context.Context) ([]uint, error) {
+	var authorIDs []uint
+	err := r.db.WithContext(ctx).
+		Model(&storyModel{}).
+		Distinct("author_id").
+		Order("author_id").
+		Pluck("author_id", &authorIDs).Error
+	if err != nil {
+		if isTransientError(err) {
+			return nil, errors.NewTransientError(err)
+		}
+		return nil, errors.NewUnexpectedError(err)
+	}
+	return authorIDs, nil
+}
+
+// ListByAuthorIDs returns every story of the given authors
+func (r *storyRepository) ListByAuthorIDs(ctx context.Context, authorIDs []uint) ([]*domain.Story, error) {
+	if len(authorIDs) == 0 {
+		return []*domain.Story{}, nil
+	}
+
+	var models []*storyModel
+	err := r.db.WithContext(ctx).
+		Where("author_id IN ?", authorIDs).
+		Order("author_id, id").
+		Find(&models).Error
+	if err != nil {
+		if isTransientError(err) {
+			return nil, errors.NewTransientError(err)
+		}
+		return nil, errors.NewUnexpectedError(err)
+	}
+
+	stories := make([]*domain.Story, len(models))
+	for i, model := range models {
+		stories[i] = toDomain(model)
+	}
+	return stories, nil
+}
+
+// CountByAuthor returns the number of stories written by the author
+func (r *storyRepository) CountByAuthor(ctx context.Context, authorID uint) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&storyModel{}).
+		Where("author_id = ?", authorID).
+		Count(&count).Error
+	if err != nil {
+		if isTransientError(err) {
+			return 0, errors.NewTransientError(err)
+		}
+		return 0, errors.NewUnexpectedError(err)
+	}
+	return count, nil
+}
+
+// DeleteByAuthor soft-deletes the author's stories and removes their likes, returning
+// the stories as they were before deletion
+func (r *storyRepository) DeleteByAuthor(ctx context.Context, authorID uint) ([]*domain.Story, error) {
+	var models []*storyModel
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("author_id = ?", authorID).
+			Order("id").
+			Find(&models).Error
+		if err != nil || len(models) == 0 {
+			return err
+		}
+
+		ids := make([]uint, len(models))
+		for i, model := range models {
+			ids[i] = model.ID
+		}
+		if err := tx.Where("story_id IN ?", ids).Delete(&storyLikeModel{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("id IN ?", ids).Delete(&storyModel{}).Error
+	})
+	if err != nil {
+		if isTransientError(err) {
+			return nil, errors.NewTransientError(err)
+		}
+		return nil, errors.NewUnexpectedError(err)
+	}
+
+	stories := make([]*domain.Story, len(models))
+	for i, model := range models {
+		stories[i] = toDomain(model)
+	}
+	return stories, nil
+}
+
+// ReassignAuthor moves every story of one author, including soft-deleted ones, to
+// another author and returns the stories that are still visible
+func (r *storyRepository) ReassignAuthor(ctx context.Context, fromID, toID uint) ([]*domain.Story, error) {
+	var models []*storyModel
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("author_id = ?", fromID).
+			Order("id").
+			Find(&models).Error
+		if err != nil {
+			return err
+		}
+		return tx.Unscoped().
+			Model(&storyModel{}).
+			Where("author_id = ?", fromID).
+			UpdateColumn("author_id", toID).Error
+	})
+	if err != nil {
+		if isTransientError(err) {
+			return nil, errors.NewTransientError(err)
+		}
+		return nil, errors.NewUnexpectedError(err)
+	}
+
+	stories := make([]*domain.Story, len(models))
+	for i, model := range models {
+		model.AuthorID = toID
+		stories[i] = toDomain(model)
+	}
+	return stories, nil
+}
+
 // toModel converts domain story to database model
 func toModel(story *domain.Story) *storyModel {
 	return &storyModel{
@@ -176,6 +526,9 @@ func toDomain(model *storyModel) *domain.Story {
 		CreatedAt:   model.CreatedAt,
 		UpdatedAt:   model.UpdatedAt,
 		PublishedAt: model.PublishedAt,
+		Views:       model.Views,
+		Likes:       model.Likes,
+		Comments:    model.Comments,
 	}
 }
 
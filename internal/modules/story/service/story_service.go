@@ -6,24 +6,73 @@ import (
 	"fmt"
 	"time"
 
+	analyticsdomain "go-monolith/internal/modules/analytics/domain"
 	"go-monolith/internal/modules/story/domain"
 	"go-monolith/internal/modules/story/repository"
 	"go-monolith/pkg/errors"
+	"go-monolith/pkg/events"
 	"go-monolith/pkg/logger"
 	"go-monolith/pkg/metrics"
 )
 
+// AuthorDirectory is the story module's port to the author module, used to keep
+// stories pointing at existing authors
+type AuthorDirectory interface {
+	// MissingAuthors returns the IDs among authorIDs that do not belong to an existing author
+	MissingAuthors(ctx context.Context, authorIDs []uint) ([]uint, error)
+}
+
 type StoryService struct {
-	repo    repository.StoryRepository
-	logger  logger.Logger
-	metrics *metrics.Client
+	repo         repository.StoryRepository
+	fingerprints repository.FingerprintRepository
+	authors      AuthorDirectory
+	events       *events.Bus
+	logger       logger.Logger
+	metrics      *metrics.Client
 }
 
-func NewStoryService(repo repository.StoryRepository, logger logger.Logger, metrics *metrics.Client) *StoryService {
+func NewStoryService(repo repository.StoryRepository, fingerprints repository.FingerprintRepository, authors AuthorDirectory, bus *events.Bus, logger logger.Logger, metrics *metrics.Client) *StoryService {
 	return &StoryService{
-		repo:    repo,
-		logger:  logger,
-		metrics: metrics,
+		repo:         repo,
+		fingerprints: fingerprints,
+		authors:      authors,
+		events:       bus,
+		logger:       logger,
+		metrics:      metrics,
+	}
+}
+
+// Event Handlers
+
+// HandleViewsCompacted adds the views analytics has compacted to the story counters
+// and publishes them per author. Reads only record a raw view, so the counters
+// trail reads until compaction.
+func (s *StoryService) HandleViewsCompacted(ctx context.Context, event events.Event) {
+	compacted, ok := event.(analyticsdomain.ViewsCompacted)
+	if !ok {
+		return
+	}
+
+	views := make(map[uint]int64, len(compacted.Views))
+	for _, story := range compacted.Views {
+		views[story.StoryID] += story.Views
+	}
+	added, err := s.repo.AddViews(ctx, views)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to add compacted views",
+			logger.String("error", err.Error()),
+			logger.Int("stories", len(views)))
+		s.metrics.IncrementCounter("story.engagement.error", []string{
+			"kind:" + string(domain.EngagementView),
+			"error_type:repository",
+		})
+		return
+	}
+	s.metrics.IncrementCounter("story.engagement.success", []string{
+		"kind:" + string(domain.EngagementView),
+	})
+	if len(added) > 0 {
+		s.events.Publish(ctx, domain.ViewsAdded{Views: added, AddedAt: time.Now()})
 	}
 }
 
@@ -48,6 +97,30 @@ func (s *StoryService) Create(ctx context.Context, title, content, authorID stri
 		return nil, err
 	}
 
+	if err := s.checkAuthor(ctx, story.AuthorID); err != nil {
+		s.logger.Warn(ctx, "Rejected story for unknown author",
+			logger.String("error", err.Error()),
+			logger.String("author_id", authorID))
+		s.metrics.IncrementCounter("story.create.error", []string{
+			"author_id:" + authorID,
+			"error_type:author",
+		})
+		return nil, err
+	}
+
+	fingerprint := domain.NewFingerprint(content)
+	if err := s.checkDuplicate(ctx, story.AuthorID, 0, fingerprint); err != nil {
+		s.logger.Warn(ctx, "Rejected duplicate story",
+			logger.String("error", err.Error()),
+			logger.String("author_id", authorID))
+		// Record duplicate rejection
+		s.metrics.IncrementCounter("story.create.error", []string{
+			"author_id:" + authorID,
+			"error_type:duplicate",
+		})
+		return nil, err
+	}
+
 	if err := s.repo.Create(ctx, story); err != nil {
 		s.logger.Error(ctx, "Failed to save story to repository",
 			logger.String("error", err.Error()),
@@ -60,6 +133,13 @@ func (s *StoryService) Create(ctx context.Context, title, content, authorID stri
 		return nil, err
 	}
 
+	s.saveFingerprint(ctx, story.ID, fingerprint)
+	s.events.Publish(ctx, domain.StoryCreated{
+		StoryID:   story.ID,
+		AuthorID:  story.AuthorID,
+		CreatedAt: story.CreatedAt,
+	})
+
 	s.logger.Info(ctx, "Story created successfully",
 		logger.String("story_id", fmt.Sprintf("%d", story.ID)),
 		logger.String("author_id", authorID))
@@ -89,11 +169,11 @@ func (s *StoryService) Update(ctx context.Context, id, title, content string) (*
 	})
 
 	story, err := s.repo.GetByID(ctx, id)
+	var baseErr *errors.BaseError
+	if stderrors.As(err, &baseErr) && baseErr.Kind == errors.ErrKindTransient {
+		story, err = s.retryGet(ctx, id)
+	}
 	if err != nil {
-		var baseErr *errors.BaseError
-		if stderrors.As(err, &baseErr) && baseErr.Kind == errors.ErrKindTransient {
-			return s.retryUpdateStory(ctx, id, title, content)
-		}
 		s.logger.Error(ctx, "Failed to get story for update",
 			logger.String("error", err.Error()),
 			logger.String("story_id", id))
@@ -117,11 +197,25 @@ func (s *StoryService) Update(ctx context.Context, id, title, content string) (*
 		return nil, err
 	}
 
-	if err := s.repo.Update(ctx, story); err != nil {
-		var baseErr *errors.BaseError
-		if stderrors.As(err, &baseErr) && baseErr.Kind == errors.ErrKindTransient {
-			return s.retryUpdate(ctx, story)
-		}
+	fingerprint := domain.NewFingerprint(story.Content)
+	if err := s.checkDuplicate(ctx, story.AuthorID, story.ID, fingerprint); err != nil {
+		s.logger.Warn(ctx, "Rejected duplicate story update",
+			logger.String("error", err.Error()),
+			logger.String("story_id", id))
+		// Record duplicate rejection
+		s.metrics.IncrementCounter("story.update.error", []string{
+			"story_id:" + id,
+			"error_type:duplicate",
+		})
+		return nil, err
+	}
+
+	// A retried update goes on to record the fingerprint and publish the event too
+	err = s.repo.Update(ctx, story)
+	if stderrors.As(err, &baseErr) && baseErr.Kind == errors.ErrKindTransient {
+		err = s.retryUpdate(ctx, story)
+	}
+	if err != nil {
 		s.logger.Error(ctx, "Failed to save story update",
 			logger.String("error", err.Error()),
 			logger.String("story_id", id))
@@ -133,6 +227,13 @@ func (s *StoryService) Update(ctx context.Context, id, title, content string) (*
 		return nil, err
 	}
 
+	s.saveFingerprint(ctx, story.ID, fingerprint)
+	s.events.Publish(ctx, domain.StoryUpdated{
+		StoryID:   story.ID,
+		AuthorID:  story.AuthorID,
+		UpdatedAt: story.UpdatedAt,
+	})
+
 	s.logger.Info(ctx, "Story updated successfully", logger.String("story_id", id))
 
 	// Record successful story update
@@ -171,7 +272,27 @@ func (s *StoryService) Publish(ctx context.Context, id string) (*domain.Story, e
 		return nil, err
 	}
 
-	story.Publish()
+	// Publishing is idempotent, so retried requests neither move the publication
+	// time nor notify followers again
+	if story.PublishedAt != nil {
+		s.logger.Info(ctx, "Story already published", logger.String("story_id", id))
+		s.metrics.IncrementCounter("story.publish.unchanged", []string{
+			"story_id:" + id,
+		})
+		return story, nil
+	}
+
+	if err := story.Publish(); err != nil {
+		s.logger.Error(ctx, "Failed to publish story domain object",
+			logger.String("error", err.Error()),
+			logger.String("story_id", id))
+		// Record validation error
+		s.metrics.IncrementCounter("story.publish.error", []string{
+			"story_id:" + id,
+			"error_type:validation",
+		})
+		return nil, err
+	}
 	if err := s.repo.Update(ctx, story); err != nil {
 		s.logger.Error(ctx, "Failed to save story publication",
 			logger.String("error", err.Error()),
@@ -184,6 +305,13 @@ func (s *StoryService) Publish(ctx context.Context, id string) (*domain.Story, e
 		return nil, err
 	}
 
+	s.events.Publish(ctx, domain.StoryPublished{
+		StoryID:     story.ID,
+		AuthorID:    story.AuthorID,
+		Title:       story.Title,
+		PublishedAt: *story.PublishedAt,
+	})
+
 	s.logger.Info(ctx, "Story published successfully", logger.String("story_id", id))
 
 	// Record successful story publication
@@ -209,7 +337,20 @@ func (s *StoryService) Delete(ctx context.Context, id string) error {
 		"story_id:" + id,
 	})
 
-	err := s.repo.Delete(ctx, id)
+	story, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to get story for deletion",
+			logger.String("error", err.Error()),
+			logger.String("story_id", id))
+		// Record fetch error
+		s.metrics.IncrementCounter("story.delete.error", []string{
+			"story_id:" + id,
+			"error_type:fetch",
+		})
+		return err
+	}
+
+	err = s.repo.Delete(ctx, id)
 	if err != nil {
 		s.logger.Error(ctx, "Failed to delete story",
 			logger.String("error", err.Error()),
@@ -222,6 +363,8 @@ func (s *StoryService) Delete(ctx context.Context, id string) error {
 		return err
 	}
 
+	s.publishDeleted(ctx, story)
+
 	s.logger.Info(ctx, "Story deleted successfully", logger.String("story_id", id))
 
 	// Record successful story deletion
@@ -238,6 +381,135 @@ func (s *StoryService) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
+// DeleteByAuthor deletes all stories of an author and returns how many were deleted.
+// A StoryDeleted event is published for each, so other modules drop what refers to them.
+func (s *StoryService) DeleteByAuthor(ctx context.Context, authorID uint) (int, error) {
+	s.logger.Info(ctx, "Deleting stories of author",
+		logger.String("author_id", fmt.Sprintf("%d", authorID)))
+
+	stories, err := s.repo.DeleteByAuthor(ctx, authorID)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to delete stories of author",
+			logger.String("error", err.Error()),
+			logger.String("author_id", fmt.Sprintf("%d", authorID)))
+		s.metrics.IncrementCounter("story.delete.error", []string{
+			"error_type:repository",
+			"type:author",
+		})
+		return 0, err
+	}
+
+	for _, story := range stories {
+		s.publishDeleted(ctx, story)
+	}
+	s.metrics.IncrementCounter("story.delete.success", []string{
+		"count:" + fmt.Sprintf("%d", len(stories)),
+		"type:author",
+	})
+	return len(stories), nil
+}
+
+// ReassignAuthor moves all stories of an author to another author and returns how
+// many were moved. A StoryUpdated event is published for each.
+func (s *StoryService) ReassignAuthor(ctx context.Context, fromID, toID uint) (int, error) {
+	s.logger.Info(ctx, "Reassigning stories of author",
+		logger.String("author_id", fmt.Sprintf("%d", fromID)),
+		logger.String("target_id", fmt.Sprintf("%d", toID)))
+
+	stories, err := s.repo.ReassignAuthor(ctx, fromID, toID)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to reassign stories of author",
+			logger.String("error", err.Error()),
+			logger.String("author_id", fmt.Sprintf("%d", fromID)))
+		s.metrics.IncrementCounter("story.reassign.error", []string{
+			"error_type:repository",
+		})
+		return 0, err
+	}
+
+	for _, story := range stories {
+		s.events.Publish(ctx, domain.StoryUpdated{
+			StoryID:   story.ID,
+			AuthorID:  story.AuthorID,
+			UpdatedAt: time.Now(),
+		})
+	}
+	s.metrics.IncrementCounter("story.reassign.success", []string{
+		"count:" + fmt.Sprintf("%d", len(stories)),
+	})
+	return len(stories), nil
+}
+
+// publishDeleted drops the fingerprint of a deleted story, so new content is not
+// rejected as a duplicate of it, and publishes StoryDeleted
+func (s *StoryService) publishDeleted(ctx context.Context, story *domain.Story) {
+	if err := s.fingerprints.Delete(ctx, story.ID); err != nil {
+		s.logger.Warn(ctx, "Failed to delete story fingerprint",
+			logger.String("error", err.Error()),
+			logger.String("story_id", fmt.Sprintf("%d", story.ID)))
+	}
+	s.events.Publish(ctx, domain.StoryDeleted{
+		StoryID:     story.ID,
+		AuthorID:    story.AuthorID,
+		PublishedAt: story.PublishedAt,
+		Views:       story.Views,
+		Likes:       story.Likes,
+		DeletedAt:   time.Now(),
+	})
+}
+
+// Like records that the user likes the story. Liking is idempotent per user;
+// the returned bool reports whether this call added a new like.
+func (s *StoryService) Like(ctx context.Context, id, userID string) (*domain.Story, bool, error) {
+	s.logger.Info(ctx, "Liking story", logger.String("story_id", id))
+
+	story, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to get story for like",
+			logger.String("error", err.Error()),
+			logger.String("story_id", id))
+		return nil, false, err
+	}
+
+	added, err := s.repo.AddLike(ctx, id, userID)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to save story like",
+			logger.String("error", err.Error()),
+			logger.String("story_id", id))
+		s.metrics.IncrementCounter("story.engagement.error", []string{
+			"story_id:" + id,
+			"kind:" + string(domain.EngagementLike),
+			"error_type:repository",
+		})
+		return nil, false, err
+	}
+	if !added {
+		return story, false, nil
+	}
+
+	s.publishEngagement(ctx, story, userID, domain.EngagementLike)
+	story.Likes++
+	return story, true, nil
+}
+
+// publishEngagement counts an engagement already stored with its counter and
+// publishes a StoryEngaged event
+func (s *StoryService) publishEngagement(ctx context.Context, story *domain.Story, userID string, kind domain.EngagementKind) {
+	id := fmt.Sprintf("%d", story.ID)
+	s.metrics.IncrementCounter("story.engagement.success", []string{
+		"story_id:" + id,
+		"kind:" + string(kind),
+	})
+	s.events.Publish(ctx, domain.StoryEngaged{
+		StoryID:    story.ID,
+		AuthorID:   story.AuthorID,
+		Title:      story.Title,
+		UserID:     userID,
+		Kind:       kind,
+		OccurredAt: time.Now(),
+	})
+}
+
 // Read Operations (Queries)
 func (s *StoryService) GetByID(ctx context.Context, id string) (*domain.Story, error) {
 	start := time.Now()
@@ -295,6 +567,33 @@ func (s *StoryService) GetByID(ctx context.Context, id string) (*domain.Story, e
 	return story, nil
 }
 
+// GetByIDs returns the existing stories among ids. Missing stories are left out.
+func (s *StoryService) GetByIDs(ctx context.Context, ids []uint) ([]*domain.Story, error) {
+	start := time.Now()
+	s.logger.Debug(ctx, "Getting stories by IDs", logger.Int("stories", len(ids)))
+
+	stories, err := s.repo.GetByIDs(ctx, ids)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to get stories",
+			logger.String("error", err.Error()),
+			logger.Int("stories", len(ids)))
+		s.metrics.IncrementCounter("story.fetch.error", []string{
+			"error_type:repository",
+			"type:ids",
+		})
+		return nil, err
+	}
+
+	s.metrics.IncrementCounter("story.fetch.success", []string{
+		"type:ids",
+	})
+	s.metrics.RecordTiming("story.fetch.duration", time.Since(start), []string{
+		"type:ids",
+	})
+	return stories, nil
+}
+
+// List returns published stories, newest first
 func (s *StoryService) List(ctx context.Context, limit, offset int) ([]*domain.Story, error) {
 	start := time.Now()
 	s.logger.Debug(ctx, "Listing stories",
@@ -334,7 +633,9 @@ func (s *StoryService) List(ctx context.Context, limit, offset int) ([]*domain.S
 	return stories, nil
 }
 
-func (s *StoryService) ListByAuthor(ctx context.Context, authorID string, limit, offset int) ([]*domain.Story, error) {
+// ListByAuthor returns the stories of an author, newest first. Drafts are only
+// included when includeDrafts is set, for callers that own the author.
+func (s *StoryService) ListByAuthor(ctx context.Context, authorID string, includeDrafts bool, limit, offset int) ([]*domain.Story, error) {
 	start := time.Now()
 	s.logger.Debug(ctx, "Listing stories by author",
 		logger.String("author_id", authorID),
@@ -349,7 +650,7 @@ func (s *StoryService) ListByAuthor(ctx context.Context, authorID string, limit,
 		"type:author",
 	})
 
-	stories, err := s.repo.ListByAuthor(ctx, authorID, limit, offset)
+	stories, err := s.repo.ListByAuthor(ctx, authorID, includeDrafts, limit, offset)
 	if err != nil {
 		s.logger.Error(ctx, "Failed to list stories by author",
 			logger.String("error", err.Error()),
@@ -383,6 +684,175 @@ func (s *StoryService) ListByAuthor(ctx context.Context, authorID string, limit,
 	return stories, nil
 }
 
+// ListFeed returns published stories of the given authors, newest first, after the cursor
+func (s *StoryService) ListFeed(ctx context.Context, authorIDs []uint, after *domain.FeedCursor, limit int) ([]*domain.Story, error) {
+	start := time.Now()
+	s.logger.Debug(ctx, "Listing feed stories",
+		logger.Int("authors", len(authorIDs)),
+		logger.Int("limit", limit))
+
+	stories, err := s.repo.ListPublishedByAuthors(ctx, authorIDs, after, limit)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to list feed stories",
+			logger.String("error", err.Error()),
+			logger.Int("limit", limit))
+		s.metrics.IncrementCounter("story.list.error", []string{
+			"error_type:repository",
+			"type:feed",
+		})
+		return nil, err
+	}
+
+	s.metrics.IncrementCounter("story.list.success", []string{
+		"count:" + fmt.Sprintf("%d", len(stories)),
+		"type:feed",
+	})
+	s.metrics.RecordTiming("story.list.duration", time.Since(start), []string{
+		"type:feed",
+	})
+	return stories, nil
+}
+
+// ListTrending returns recently published stories ranked by engagement
+func (s *StoryService) ListTrending(ctx context.Context, limit, offset int) ([]*domain.Story, error) {
+	start := time.Now()
+	s.logger.Debug(ctx, "Listing trending stories",
+		logger.Int("limit", limit),
+		logger.Int("offset", offset))
+
+	stories, err := s.repo.ListTrending(ctx, time.Now().Add(-domain.TrendingWindow), limit, offset)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to list trending stories",
+			logger.String("error", err.Error()),
+			logger.Int("limit", limit),
+			logger.Int("offset", offset))
+		s.metrics.IncrementCounter("story.list.error", []string{
+			"error_type:repository",
+			"type:trending",
+		})
+		return nil, err
+	}
+
+	s.metrics.IncrementCounter("story.list.success", []string{
+		"count:" + fmt.Sprintf("%d", len(stories)),
+		"type:trending",
+	})
+	s.metrics.RecordTiming("story.list.duration", time.Since(start), []string{
+		"type:trending",
+	})
+	return stories, nil
+}
+
+// checkAuthor rejects stories whose author does not exist. Unlike duplicate
+// detection, a failed lookup blocks creation so no orphaned story is written.
+func (s *StoryService) checkAuthor(ctx context.Context, authorID uint) error {
+	missing, err := s.authors.MissingAuthors(ctx, []uint{authorID})
+	if err != nil {
+		return err
+	}
+	if len(missing) > 0 {
+		return domain.NewUnknownAuthorError(authorID)
+	}
+	return nil
+}
+
+// CountByAuthor returns the number of stories written by the author
+func (s *StoryService) CountByAuthor(ctx context.Context, authorID uint) (int64, error) {
+	count, err := s.repo.CountByAuthor(ctx, authorID)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to count stories of author",
+			logger.String("error", err.Error()),
+			logger.String("author_id", fmt.Sprintf("%d", authorID)))
+		return 0, err
+	}
+	return count, nil
+}
+
+// FindOrphans reports the stories whose author no longer exists
+func (s *StoryService) FindOrphans(ctx context.Context) (*domain.OrphanReport, error) {
+	start := time.Now()
+
+	authorIDs, err := s.repo.ListAuthorIDs(ctx)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to list story authors", logger.String("error", err.Error()))
+		s.metrics.IncrementCounter("story.orphans.error", nil)
+		return nil, err
+	}
+
+	missing, err := s.authors.MissingAuthors(ctx, authorIDs)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to check story authors", logger.String("error", err.Error()))
+		s.metrics.IncrementCounter("story.orphans.error", nil)
+		return nil, err
+	}
+
+	stories, err := s.repo.ListByAuthorIDs(ctx, missing)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to list orphaned stories", logger.String("error", err.Error()))
+		s.metrics.IncrementCounter("story.orphans.error", nil)
+		return nil, err
+	}
+
+	report := &domain.OrphanReport{
+		CheckedAuthors:   len(authorIDs),
+		MissingAuthorIDs: missing,
+		Stories:          stories,
+	}
+	s.metrics.IncrementCounter("story.orphans.success", []string{
+		"count:" + fmt.Sprintf("%d", len(stories)),
+	})
+	s.metrics.RecordTiming("story.orphans.duration", time.Since(start), nil)
+	return report, nil
+}
+
+// checkDuplicate returns a DuplicateStoryError if the fingerprint matches another live
+// story of the same author, either exactly or with an estimated similarity at or above
+// domain.DuplicateThreshold. storyID is the story being updated, 0 for a new story,
+// and is never matched against itself. Lookup failures are logged and do not block
+// the write.
+func (s *StoryService) checkDuplicate(ctx context.Context, authorID, storyID uint, fingerprint domain.Fingerprint) error {
+	exact, err := s.fingerprints.FindByContentHash(ctx, authorID, fingerprint.ContentHash)
+	if err != nil {
+		s.logger.Warn(ctx, "Failed to look up exact duplicate stories", logger.String("error", err.Error()))
+		return nil
+	}
+	for _, id := range exact {
+		if id != storyID {
+			return domain.NewDuplicateStoryError(id, 1)
+		}
+	}
+
+	candidates, err := s.fingerprints.FindCandidates(ctx, authorID, fingerprint)
+	if err != nil {
+		s.logger.Warn(ctx, "Failed to look up similar stories", logger.String("error", err.Error()))
+		return nil
+	}
+	delete(candidates, storyID)
+
+	var bestID uint
+	var bestSimilarity float64
+	for id, candidate := range candidates {
+		similarity := fingerprint.Similarity(candidate)
+		if similarity > bestSimilarity || (similarity == bestSimilarity && id < bestID) {
+			bestID, bestSimilarity = id, similarity
+		}
+	}
+	if bestSimilarity >= domain.DuplicateThreshold {
+		return domain.NewDuplicateStoryError(bestID, bestSimilarity)
+	}
+	return nil
+}
+
+// saveFingerprint records the fingerprint of a stored story; failures only affect
+// future duplicate detection, so they are logged rather than returned
+func (s *StoryService) saveFingerprint(ctx context.Context, storyID uint, fingerprint domain.Fingerprint) {
+	if err := s.fingerprints.Save(ctx, storyID, fingerprint); err != nil {
+		s.logger.Warn(ctx, "Failed to save story fingerprint",
+			logger.String("error", err.Error()),
+			logger.String("story_id", fmt.Sprintf("%d", storyID)))
+	}
+}
+
 // Helper method for retrying operations
 func (s *StoryService) retryGet(ctx context.Context, id string) (*domain.Story, error) {
 	for i := 0; i < 3; i++ {
@@ -399,39 +869,19 @@ func (s *StoryService) retryGet(ctx context.Context, id string) (*domain.Story,
 	return nil, errors.NewUnexpectedError(fmt.Errorf("max retries exceeded"))
 }
 
-func (s *StoryService) retryUpdateStory(ctx context.Context, id, title, content string) (*domain.Story, error) {
+func (s *StoryService) retryUpdate(ctx context.Context, story *domain.Story) error {
 	for i := 0; i < 3; i++ {
-		story, err := s.repo.GetByID(ctx, id)
+		err := s.repo.Update(ctx, story)
 		if err == nil {
-			story.Update(title, content)
-			if err := s.repo.Update(ctx, story); err != nil {
-				var baseErr *errors.BaseError
-				if !stderrors.As(err, &baseErr) || baseErr.Kind != errors.ErrKindTransient {
-					return nil, err
-				}
-			}
-			return story, nil
+			return nil
 		}
 		var baseErr *errors.BaseError
 		if !stderrors.As(err, &baseErr) || baseErr.Kind != errors.ErrKindTransient {
-			return nil, err
+			return err
 		}
 		time.Sleep(time.Duration(i+1) * 100 * time.Millisecond)
 	}
-	return nil, errors.NewUnexpectedError(fmt.Errorf("max retries exceeded"))
-}
-
-func (s *StoryService) retryUpdate(ctx context.Context, story *domain.Story) (*domain.Story, error) {
-	for i := 0; i < 3; i++ {
-		if err := s.repo.Update(ctx, story); err != nil {
-			var baseErr *errors.BaseError
-			if !stderrors.As(err, &baseErr) || baseErr.Kind != errors.ErrKindTransient {
-				return nil, err
-			}
-		}
-		return story, nil
-	}
-	return nil, errors.NewUnexpectedError(fmt.Errorf("max retries exceeded"))
+	return errors.NewUnexpectedError(fmt.Errorf("max retries exceeded"))
 }
 
 // TODO: Add domain events like story published, story updated, story deleted
@@ -3,8 +3,10 @@ package story
 import (
 	"gorm.io/gorm"
 
+	analyticsdomain "go-monolith/internal/modules/analytics/domain"
 	"go-monolith/internal/modules/story/repository"
 	"go-monolith/internal/modules/story/service"
+	"go-monolith/pkg/events"
 	"go-monolith/pkg/logger"
 	"go-monolith/pkg/metrics"
 )
@@ -13,10 +15,15 @@ type Module struct {
 	StoryService *service.StoryService
 }
 
-func NewModule(db *gorm.DB, logger logger.Logger, metrics *metrics.Client) *Module {
+func NewModule(db *gorm.DB, bus *events.Bus, authors service.AuthorDirectory, logger logger.Logger, metrics *metrics.Client) *Module {
 	repo := repository.NewStoryRepository(db)
+	fingerprints := repository.NewFingerprintRepository(db)
+	storyService := service.NewStoryService(repo, fingerprints, authors, bus, logger, metrics)
+
+	// Fold compacted views into the story counters
+	bus.Subscribe(analyticsdomain.EventViewsCompacted, storyService.HandleViewsCompacted)
 
 	return &Module{
-		StoryService: service.NewStoryService(repo, logger, metrics),
+		StoryService: storyService,
 	}
 }
@@ -2,9 +2,10 @@ package auth
 
 import (
 	"fmt"
-	"net/http"
 
 	appctx "go-monolith/pkg/context"
+	"go-monolith/pkg/errors"
+	"go-monolith/pkg/problem"
 
 	"github.com/gin-gonic/gin"
 )
@@ -24,18 +25,14 @@ func AuthMiddleware() gin.HandlerFunc {
 		// Get access-token header
 		accessToken := c.GetHeader("access-token")
 		if accessToken == "" {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
-				"error": "access-token header is required",
-			})
+			problem.Abort(c, errors.NewUnauthorizedError("access-token header is required"))
 			return
 		}
 
 		// Look up user ID in mock cache
 		userID, exists := mockTokenCache[accessToken]
 		if !exists {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
-				"error": "invalid access token",
-			})
+			problem.Abort(c, errors.NewUnauthorizedError("invalid access token"))
 			return
 		}
 
@@ -58,9 +55,7 @@ func RequirePermission(verifier PermissionVerifier, action string, resource stri
 		// Check user ID
 		userID := ctx.UserID()
 		if userID == "" {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
-				"error": "user not authenticated",
-			})
+			problem.Abort(c, errors.NewUnauthorizedError("user not authenticated"))
 			return
 		}
 
@@ -69,9 +64,7 @@ func RequirePermission(verifier PermissionVerifier, action string, resource stri
 
 		// Verify permission
 		if !verifier.Verify(c.Request.Context(), action, resource, resourceID) {
-			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{
-				"error": "permission denied",
-			})
+			problem.Abort(c, errors.NewForbiddenError("permission denied"))
 			return
 		}
 
@@ -0,0 +1,57 @@
+package auth
+
+import (
+	"context"
+
+	appctx "go-monolith/pkg/context"
+)
+
+// OwnershipResolver reports whether a user owns a resource, e.g. whether the
+// user manages the author of a story
+type OwnershipResolver interface {
+	IsOwner(ctx context.Context, userID string, resource string, resourceID string) (bool, error)
+}
+
+// OwnershipVerifier requires the current user to own the resource for guarded
+// actions on guarded resources and delegates every other check to next
+type OwnershipVerifier struct {
+	next      PermissionVerifier
+	resolver  OwnershipResolver
+	resources map[string]bool
+	actions   map[string]bool
+}
+
+// NewOwnershipVerifier guards the given actions on the given resources by ownership
+func NewOwnershipVerifier(next PermissionVerifier, resolver OwnershipResolver, resources []string, actions []string) *OwnershipVerifier {
+	v := &OwnershipVerifier{
+		next:      next,
+		resolver:  resolver,
+		resources: make(map[string]bool, len(resources)),
+		actions:   make(map[string]bool, len(actions)),
+	}
+	for _, resource := range resources {
+		v.resources[resource] = true
+	}
+	for _, action := range actions {
+		v.actions[action] = true
+	}
+	return v
+}
+
+// Verify implements PermissionVerifier interface
+func (v *OwnershipVerifier) Verify(ctx context.Context, action string, resource string, resourceID string) bool {
+	if resourceID == "" || !v.resources[resource] || !v.actions[action] {
+		return v.next.Verify(ctx, action, resource, resourceID)
+	}
+
+	userID := appctx.FromContext(ctx).UserID()
+	if userID == "" {
+		return false
+	}
+	// Lookup failures deny access rather than fall back to the next verifier
+	owner, err := v.resolver.IsOwner(ctx, userID, resource, resourceID)
+	if err != nil || !owner {
+		return false
+	}
+	return v.next.Verify(ctx, action, resource, resourceID)
+}
@@ -0,0 +1,19 @@
+package cache
+
+import (
+	"context"
+	"time"
+)
+
+// Cache defines the contract for a key/value cache. Values are opaque bytes so the
+// in-process LRU and shared backends such as Redis or memcached are interchangeable.
+// Implementations must be safe for concurrent use.
+type Cache interface {
+	// Get returns the value stored under key; found is false for missing or expired entries
+	Get(ctx context.Context, key string) (value []byte, found bool, err error)
+	// Set stores value under key for ttl, replacing any existing entry. A ttl of zero
+	// keeps the entry until it is evicted or deleted.
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	// Delete removes the entries stored under keys; deleting a missing key is not an error
+	Delete(ctx context.Context, keys ...string) error
+}
@@ -1,6 +1,9 @@
 package errors
 
-import "fmt"
+import (
+	stderrors "errors"
+	"fmt"
+)
 
 // ErrKind represents different types of errors that can occur
 type ErrKind int
@@ -33,6 +36,26 @@ func (e *BaseError) Unwrap() error {
 	return e.Err
 }
 
+// ErrKind returns the kind of the error. Errors that embed BaseError
+// (domain, HTTP and database errors) inherit this method.
+func (e *BaseError) ErrKind() ErrKind {
+	return e.Kind
+}
+
+// kinded is implemented by BaseError and every error type embedding it
+type kinded interface {
+	ErrKind() ErrKind
+}
+
+// KindOf returns the kind of the first error in err's chain that carries one
+func KindOf(err error) (ErrKind, bool) {
+	var k kinded
+	if stderrors.As(err, &k) {
+		return k.ErrKind(), true
+	}
+	return 0, false
+}
+
 // Common error constructors
 func NewNotFoundError(resource string, id string) error {
 	return &BaseError{
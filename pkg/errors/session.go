@@ -15,6 +15,12 @@ func NewSessionError(message string, err error) error {
 	}
 }
 
+// Unauthorized reports whether the error rejects the client's session, as opposed to
+// a failure to create or delete one
+func (e *SessionError) Unauthorized() bool {
+	return e.Err == nil
+}
+
 func NewSessionExpiredError() error {
 	return NewSessionError("session has expired", nil)
 }
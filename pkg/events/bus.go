@@ -0,0 +1,68 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"go-monolith/pkg/logger"
+)
+
+// Event is a domain event published by a module
+type Event interface {
+	// EventName identifies the event type handlers subscribe to
+	EventName() string
+}
+
+// Handler reacts to a published event
+type Handler func(ctx context.Context, event Event)
+
+// Bus is an in-process publish/subscribe dispatcher for domain events.
+// Handlers run synchronously in subscription order on the publisher's goroutine,
+// so they should be quick; a panicking handler is logged and does not affect the others.
+type Bus struct {
+	mu       sync.RWMutex
+	handlers map[string][]Handler
+	logger   logger.Logger
+}
+
+// NewBus creates a new event bus
+func NewBus(logger logger.Logger) *Bus {
+	return &Bus{
+		handlers: make(map[string][]Handler),
+		logger:   logger,
+	}
+}
+
+// Subscribe registers a handler for events with the given name
+func (b *Bus) Subscribe(name string, handler Handler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.handlers[name] = append(b.handlers[name], handler)
+}
+
+// Publish delivers an event to every handler subscribed to its name
+func (b *Bus) Publish(ctx context.Context, event Event) {
+	if b == nil {
+		return
+	}
+
+	b.mu.RLock()
+	handlers := b.handlers[event.EventName()]
+	b.mu.RUnlock()
+
+	for _, handler := range handlers {
+		b.dispatch(ctx, event, handler)
+	}
+}
+
+func (b *Bus) dispatch(ctx context.Context, event Event, handler Handler) {
+	defer func() {
+		if r := recover(); r != nil {
+			b.logger.Error(ctx, "event handler panicked",
+				logger.String("event", event.EventName()),
+				logger.String("panic", fmt.Sprint(r)))
+		}
+	}()
+	handler(ctx, event)
+}
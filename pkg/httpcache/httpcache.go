@@ -0,0 +1,137 @@
+package httpcache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	appctx "go-monolith/pkg/context"
+	"go-monolith/pkg/problem"
+)
+
+// DefaultCacheControl lets only the client store responses, since they may depend on
+// the caller, and makes it revalidate before each use, which is cheap with the
+// validators set here
+const DefaultCacheControl = "private, no-cache"
+
+var (
+	mu                  sync.RWMutex
+	defaultCacheControl = DefaultCacheControl
+	routeCacheControl   = map[string]string{}
+)
+
+// SetCacheControl configures the Cache-Control value sent per route. Routes are
+// matched by the version serving the request and its registered path, such as
+// /v2.0/stories/:id, whether the version came from the path or was negotiated;
+// other routes get defaultValue.
+func SetCacheControl(defaultValue string, routes map[string]string) {
+	mu.Lock()
+	defer mu.Unlock()
+	if defaultValue != "" {
+		defaultCacheControl = defaultValue
+	}
+	routeCacheControl = routes
+}
+
+// ParseRoutes parses per-route Cache-Control values written as
+// "/v1.2/stories=public, max-age=60; /v2.0/stories/:id=private, no-cache"
+func ParseRoutes(spec string) (map[string]string, error) {
+	routes := map[string]string{}
+	for _, entry := range strings.Split(spec, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		route, value, ok := strings.Cut(entry, "=")
+		route, value = strings.TrimSpace(route), strings.TrimSpace(value)
+		if !ok || route == "" || value == "" {
+			return nil, fmt.Errorf("invalid cache control entry %q, expected route=value", entry)
+		}
+		routes[route] = value
+	}
+	return routes, nil
+}
+
+// WriteJSON renders body as JSON with a strong ETag over the rendered bytes and, when
+// lastModified is set, a Last-Modified header. It responds 304 Not Modified without
+// a body when the request's If-None-Match or If-Modified-Since shows the client
+// already has this representation.
+func WriteJSON(c *gin.Context, body interface{}, lastModified time.Time) {
+	rendered, err := json.Marshal(body)
+	if err != nil {
+		problem.Render(c, err)
+		return
+	}
+
+	sum := sha256.Sum256(rendered)
+	etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+	header := c.Writer.Header()
+	header.Set("ETag", etag)
+	header.Set("Cache-Control", cacheControlFor(routeKey(c)))
+	// HTTP dates have second precision
+	lastModified = lastModified.UTC().Truncate(time.Second)
+	if !lastModified.IsZero() {
+		header.Set("Last-Modified", lastModified.Format(http.TimeFormat))
+	}
+
+	if notModified(c.Request, etag, lastModified) {
+		c.Status(http.StatusNotModified)
+		return
+	}
+	c.Data(http.StatusOK, "application/json; charset=utf-8", rendered)
+}
+
+// routeKey returns the request's route under its version prefix, so /stories/:id
+// served as v2.0 after negotiation shares the key of /v2.0/stories/:id
+func routeKey(c *gin.Context) string {
+	route := c.FullPath()
+	version := appctx.FromContext(c.Request.Context()).APIVersion()
+	if version == "" {
+		return route
+	}
+	return "/" + version + strings.TrimPrefix(route, "/"+version)
+}
+
+func cacheControlFor(route string) string {
+	mu.RLock()
+	defer mu.RUnlock()
+	if value, ok := routeCacheControl[route]; ok {
+		return value
+	}
+	return defaultCacheControl
+}
+
+// notModified evaluates the request's conditional headers as in RFC 9110: when
+// If-None-Match is present If-Modified-Since is ignored
+func notModified(r *http.Request, etag string, lastModified time.Time) bool {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		return false
+	}
+	if inm := r.Header.Get("If-None-Match"); inm != "" {
+		return etagMatches(inm, etag)
+	}
+	if ims := r.Header.Get("If-Modified-Since"); ims != "" && !lastModified.IsZero() {
+		since, err := http.ParseTime(ims)
+		return err == nil && !lastModified.After(since)
+	}
+	return false
+}
+
+// etagMatches reports whether any entity tag in an If-None-Match list matches etag.
+// If-None-Match uses weak comparison, so a W/ prefix is ignored.
+func etagMatches(list, etag string) bool {
+	for _, candidate := range strings.Split(list, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,181 @@
+package problem
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	appctx "go-monolith/pkg/context"
+	"go-monolith/pkg/errors"
+	"go-monolith/pkg/logger"
+)
+
+// ContentType is the media type of problem responses
+const ContentType = "application/problem+json"
+
+// Problem types identify the kind of error independently of the message. Errors
+// without a kind of their own use about:blank, whose title is the status text.
+const (
+	TypeValidation   = "/problems/validation"
+	TypeUnauthorized = "/problems/unauthorized"
+	TypeNotFound     = "/problems/not-found"
+	TypeUnavailable  = "/problems/unavailable"
+	TypeInternal     = "/problems/internal"
+	TypeBlank        = "about:blank"
+)
+
+// Problem is a problem details object as defined by RFC 7807
+type Problem struct {
+	Type     string `json:"type"`
+	Title    string `json:"title"`
+	Status   int    `json:"status"`
+	Detail   string `json:"detail,omitempty"`
+	Instance string `json:"instance,omitempty"`
+	TraceID  string `json:"traceId,omitempty"`
+	// Extensions are additional members, such as the fields a request may select
+	Extensions map[string]interface{} `json:"-"`
+}
+
+// extender is implemented by errors that describe themselves with extension members
+type extender interface {
+	ProblemExtensions() map[string]interface{}
+}
+
+// extendedError adds extension members to the problem describing the wrapped error
+type extendedError struct {
+	error
+	extensions map[string]interface{}
+}
+
+func (e *extendedError) Unwrap() error {
+	return e.error
+}
+
+func (e *extendedError) ProblemExtensions() map[string]interface{} {
+	return e.extensions
+}
+
+// WithExtensions returns err with extension members for the problem describing it
+func WithExtensions(err error, extensions map[string]interface{}) error {
+	return &extendedError{error: err, extensions: extensions}
+}
+
+// MarshalJSON renders the extension members alongside the standard ones
+func (p *Problem) MarshalJSON() ([]byte, error) {
+	type standard Problem
+	body, err := json.Marshal((*standard)(p))
+	if err != nil || len(p.Extensions) == 0 {
+		return body, err
+	}
+	members := make(map[string]interface{}, len(p.Extensions)+6)
+	for key, value := range p.Extensions {
+		members[key] = value
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return nil, err
+	}
+	for key, value := range fields {
+		members[key] = value
+	}
+	return json.Marshal(members)
+}
+
+// New describes err for clients. Validation, session, not found and client HTTP
+// errors keep their message as the detail; any other error is described generically and
+// logged in full, so internal messages such as database errors never reach clients.
+func New(ctx context.Context, err error) *Problem {
+	p := &Problem{Status: Status(err), TraceID: appctx.FromContext(ctx).TraceID()}
+	var httpErr *errors.HTTPError
+	switch {
+	case stderrors.As(err, &httpErr):
+		p.Type, p.Title = TypeBlank, http.StatusText(p.Status)
+		if p.Status < http.StatusInternalServerError {
+			p.Detail = httpErr.Message
+		}
+	case p.Status == http.StatusBadRequest:
+		p.Type, p.Title, p.Detail = TypeValidation, "Invalid request", err.Error()
+	case p.Status == http.StatusUnauthorized:
+		p.Type, p.Title, p.Detail = TypeUnauthorized, "Authentication required", err.Error()
+	case p.Status == http.StatusNotFound:
+		p.Type, p.Title, p.Detail = TypeNotFound, "Resource not found", err.Error()
+	case p.Status == http.StatusServiceUnavailable:
+		p.Type, p.Title = TypeUnavailable, "Service temporarily unavailable"
+		p.Detail = "the request could not be completed right now, please retry"
+	default:
+		p.Type, p.Title = TypeInternal, "Internal server error"
+		p.Detail = "the request could not be completed"
+	}
+
+	if p.Status >= http.StatusInternalServerError {
+		logger.Default().Error(ctx, "Request failed",
+			logger.Int("status", p.Status),
+			logger.String("error", errorText(err)),
+		)
+	}
+
+	var ext extender
+	if stderrors.As(err, &ext) {
+		p.Extensions = ext.ProblemExtensions()
+	}
+	return p
+}
+
+// Status returns the HTTP status err is rendered with
+func Status(err error) int {
+	var httpErr *errors.HTTPError
+	if stderrors.As(err, &httpErr) {
+		return httpErr.StatusCode
+	}
+	// An expired or invalid session asks the client to authenticate again, while
+	// failing to create or delete a session is a server error
+	var sessionErr *errors.SessionError
+	if stderrors.As(err, &sessionErr) && sessionErr.Unauthorized() {
+		return http.StatusUnauthorized
+	}
+	kind, ok := errors.KindOf(err)
+	switch {
+	case ok && kind == errors.ErrKindValidation:
+		return http.StatusBadRequest
+	case ok && kind == errors.ErrKindNotFound:
+		return http.StatusNotFound
+	case ok && kind == errors.ErrKindTransient:
+		return http.StatusServiceUnavailable
+	case ok && kind == errors.ErrKindDatabase:
+		return http.StatusInternalServerError
+	}
+	return http.StatusInternalServerError
+}
+
+// Render responds to the request with the problem describing err
+func Render(c *gin.Context, err error) {
+	p := New(c.Request.Context(), err)
+	p.Instance = c.Request.URL.Path
+	Write(c, p)
+}
+
+// Abort responds with the problem describing err and stops the handler chain
+func Abort(c *gin.Context, err error) {
+	Render(c, err)
+	c.Abort()
+}
+
+// Write responds with p as application/problem+json
+func Write(c *gin.Context, p *Problem) {
+	body, err := json.Marshal(p)
+	if err != nil {
+		c.Status(p.Status)
+		return
+	}
+	c.Data(p.Status, ContentType, body)
+}
+
+func errorText(err error) string {
+	if err == nil {
+		return "<nil>"
+	}
+	return err.Error()
+}
@@ -0,0 +1,104 @@
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go-monolith/pkg/logger"
+	"go-monolith/pkg/metrics"
+)
+
+// Job is a unit of background work run periodically by the Scheduler
+type Job interface {
+	Name() string
+	Run(ctx context.Context) error
+}
+
+type entry struct {
+	job      Job
+	interval time.Duration
+}
+
+// Scheduler runs registered jobs at fixed intervals until stopped
+type Scheduler struct {
+	entries []entry
+	logger  logger.Logger
+	metrics *metrics.Client
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+}
+
+// New creates a new scheduler
+func New(logger logger.Logger, metrics *metrics.Client) *Scheduler {
+	return &Scheduler{
+		logger:  logger,
+		metrics: metrics,
+	}
+}
+
+// Register adds a job to run every interval; it must be called before Start
+func (s *Scheduler) Register(job Job, interval time.Duration) {
+	s.entries = append(s.entries, entry{job: job, interval: interval})
+}
+
+// Start launches one goroutine per registered job
+func (s *Scheduler) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+
+	for _, e := range s.entries {
+		s.wg.Add(1)
+		go s.loop(ctx, e)
+	}
+}
+
+// Stop cancels running jobs and waits for them to return or for ctx to expire
+func (s *Scheduler) Stop(ctx context.Context) {
+	if s.cancel == nil {
+		return
+	}
+	s.cancel()
+
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+	}
+}
+
+func (s *Scheduler) loop(ctx context.Context, e entry) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.run(ctx, e.job)
+		}
+	}
+}
+
+func (s *Scheduler) run(ctx context.Context, job Job) {
+	start := time.Now()
+	tags := []string{"job:" + job.Name()}
+
+	if err := job.Run(ctx); err != nil {
+		s.logger.Error(ctx, "Scheduled job failed",
+			logger.String("job", job.Name()),
+			logger.String("error", err.Error()))
+		s.metrics.IncrementCounter("scheduler.job.error", tags)
+		return
+	}
+
+	s.metrics.IncrementCounter("scheduler.job.success", tags)
+	s.metrics.RecordTiming("scheduler.job.duration", time.Since(start), tags)
+}